@@ -1,10 +1,12 @@
 // Code generated by go-bindata.
 // sources:
+// assets/field.md
 // assets/full.md
 // assets/index.md
 // assets/models.md
 // assets/repos.md
 // assets/style.css
+// assets/table.md
 // assets/wrap.html
 // DO NOT EDIT!
 
@@ -74,7 +76,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsFullMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x52\x4d\x8b\xdb\x30\x10\xbd\xeb\x57\x0c\xe8\xd2\x75\x91\x73\xcf\xb5\xa5\x50\xf0\x96\x25\xc9\xf6\x52\x0a\xab\x75\x26\x59\x81\x2c\x07\xcb\x5b\x28\xb2\xfe\x7b\x19\xc9\xb1\x65\xa3\x94\xb0\xbe\x58\xf3\xf1\xe6\xe3\xbd\xe1\xe0\x5c\xe9\x3d\x63\xce\xa9\x13\x94\x5f\xd1\xd6\x9d\xba\xf4\xaa\x35\xde\x3b\xb7\xb6\xd1\x1c\x29\x57\xc0\x4f\xec\xac\x6a\xcd\x96\xd0\xe3\x9b\xe2\x54\x62\x87\x1a\xa5\xc5\xb2\xc2\x3f\xa8\xbd\x67\x02\x46\x4f\x48\x5e\x45\x3f\x27\xae\x3d\x76\x4a\xea\xb9\x8d\x80\xe7\x5d\x15\x40\xcf\xbb\x8a\xda\x72\x0e\x07\xf9\xaa\xd1\xd2\xb4\x9d\x34\x67\x84\x32\x3a\xca\x4a\xd9\xde\x7b\x01\xbf\xc2\x36\xbf\x3f\xf1\x88\xda\xeb\xf7\xb3\xf7\x0f\x6c\x9a\x3c\x8f\xe3\x23\x0b\xe0\x16\xb8\x90\xbf\xa4\x80\xb1\xa2\xf8\xa6\x50\x1f\x6d\x51\x24\xd5\xa2\xeb\xde\x29\xf2\x30\xce\xff\x37\x46\x64\xf6\x84\x1d\x9a\x1a\xad\xf7\x45\x30\x2c\xf4\xed\x36\xb4\x9b\x63\xb1\x6a\x5c\xf0\x3a\x44\x3e\x9a\xcc\x06\x9b\x6c\x95\x9b\xf8\x19\x59\x24\xdc\xae\xb9\x8a\x63\x1f\xfe\x5e\x30\xae\xc7\x61\x5f\xbf\x61\x23\xe9\x82\xc8\xbb\x85\x17\xe7\xc6\xf8\x4b\x4c\xae\xd0\x9c\xfb\xb7\xa0\x7e\x7c\x86\x03\xb8\x7a\xc7\x5e\x31\xf5\xa9\xc3\x5a\xc5\xcb\x63\x02\x26\x2b\x00\x92\xd8\x02\xb3\xaf\x25\xb1\xc2\x04\x84\x57\xc8\x1d\x7d\xb3\x3a\xd3\x3e\x84\x78\x94\x97\x8b\x32\x67\x7b\xdd\xe0\x6a\x33\xf6\xd8\x1e\x51\xc3\x10\x6f\x12\x06\x08\xcc\xc0\x00\x5f\xda\xa6\x41\xd3\x33\x11\xbe\x41\xe4\xff\x62\x3e\x83\xb9\x05\x69\x90\x0a\x14\x5a\x90\x08\x9b\x86\x5e\x76\x93\x8b\x93\x18\x4f\x92\xe8\x79\x80\x01\x56\x25\xee\x07\xf3\x55\x38\x39\x8e\xa4\xea\x47\xea\x2d\x2a\x39\x57\x8e\x04\x25\x5c\xaf\x38\xff\x6e\x5e\xdb\x77\x73\xdc\xe1\x69\xa2\x7d\x74\xc1\x7c\x89\x8c\x15\x87\xb6\x97\x9a\x34\xd4\x68\x56\xa8\x82\xb1\xb5\x2e\x3f\x64\x83\x59\x51\x66\x25\x16\x25\x32\x4c\xde\xcf\xd1\xed\xed\xf3\x6b\x2f\x1d\xff\x02\x00\x00\xff\xff\x7d\xab\x57\x72\x96\x05\x00\x00")
+var _assetsFieldMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x54\xc1\x6e\xe3\x20\x10\xbd\xf3\x15\x48\xb9\xec\x46\x8a\x73\xcf\xb5\x55\xa5\xaa\x6d\x54\xa5\xed\x61\x55\xad\x14\x6a\x4f\x12\x24\x8c\xb3\x86\xa8\xbb\x22\xfc\xfb\x0e\x60\x1b\xec\xd0\xf6\x56\x5f\x98\x61\xde\x7b\x9e\x19\x06\x8c\x79\xe7\xfa\x40\x8b\x1b\x0e\xa2\xb2\x76\x46\x8d\x29\xac\x25\x64\xfe\x8a\xc6\x33\x7b\x13\x50\x3c\x34\x15\x08\x6b\x7f\xff\x58\xd6\xce\x52\xcb\x71\xa4\x78\xd9\xdc\x3f\x32\x7d\xb0\xf6\x27\x5d\xd2\x81\x96\x25\x8c\xa0\xb8\xbb\x66\x35\x02\xe7\x84\x18\xc3\x77\xb4\xd8\xc0\x0e\x5a\x90\x25\x28\xdc\xf4\x8e\xa2\xba\x59\x79\xd1\x18\x0b\xb9\x66\xff\x92\x07\x5d\x66\x38\xc5\x7d\x25\x13\x05\xe6\xc6\x80\xac\x5c\x87\x10\x77\x0d\xaa\x6c\xf9\x51\xf3\x46\xe2\x4e\x1f\x99\xcd\xe8\x53\x79\x80\x9a\x11\xb2\xa0\xae\xc2\x15\xdd\x22\x38\x28\x85\x8a\xb7\xa1\xde\x2e\xc7\x7f\x47\xdc\x42\xac\x33\x52\x6c\x08\x6c\x3b\xdd\x94\x72\x0f\x72\xef\xd2\x41\x52\x30\x57\x74\x60\xf5\xb1\x0c\xed\xb1\x85\x92\xab\x90\xed\x82\x0e\x5e\x42\x4e\x10\x19\xfe\x53\xc9\x44\x48\xd5\x5b\x09\xaf\x8b\x64\x38\xd7\xb0\x63\x27\xa1\x3d\xab\xb3\xd3\x1a\x87\x70\x5f\x26\xc2\x36\xf0\xe7\xc4\x5b\xa8\x9c\x7e\x14\xea\x77\xad\xfd\x05\x0a\xc1\x42\xe1\x0f\xd7\x4d\xa4\xad\x1b\x4d\xe5\x49\x88\x9e\x86\xfe\x1a\xdd\x0f\xf0\xdd\xc8\x85\x93\xc2\xc1\x9f\xd1\xab\x46\x2a\xdd\x32\x2e\xb5\xa2\x4c\x56\xf4\x56\x56\xf0\x17\x14\x21\x77\x1c\xbd\xb3\x3f\x4a\x5c\x30\x63\x2e\xb9\x3b\x73\xb2\x70\xdf\x79\x91\x2e\xfe\x43\xed\x96\xc9\x3d\x44\x79\xac\xd7\xa9\x58\x8b\x02\xc3\xdc\xa3\xbd\xf5\x53\xd4\x0b\x62\x17\xc8\xd0\xc2\x90\xa6\x19\xdf\xcf\x90\xf4\x03\x3b\x1e\xb9\xdc\x2b\x9f\x76\xef\x10\xe2\xaf\x23\x8a\xfa\xa1\xc7\xd5\x73\x70\xbd\x6a\xea\x1a\xa4\x26\xe3\x44\xa7\x6b\x92\x75\xd4\x7f\x8d\xc3\xf8\xe1\x5b\x70\x11\x4f\xef\xdb\x99\x4e\x24\x3e\x21\xe7\x69\x59\xc2\x08\x8a\xbb\x5d\x89\xc9\x2d\x8c\xab\xeb\xd8\xad\x7c\x6b\x4e\xb2\xc2\x8b\x1d\x9a\xd6\xf9\x34\xde\x74\x7c\xf2\x9e\x1b\xcd\xfc\xf4\x08\x90\x13\x0a\x3e\x51\xd3\xae\xba\x33\xcc\xb6\x34\xf6\x71\x24\xf1\x2d\x7d\xb8\x6c\x40\xef\xfe\x07\x98\xac\xaa\xef\xe9\x05\x00\x00")
+
+func assetsFieldMdBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsFieldMd,
+		"assets/field.md",
+	)
+}
+
+func assetsFieldMd() (*asset, error) {
+	bytes, err := assetsFieldMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/field.md", size: 1513, mode: os.FileMode(420), modTime: time.Unix(1792296252, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assetsFullMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x53\x5d\x4b\xec\x30\x10\x7d\xcf\xaf\x08\xf4\x45\x7b\x69\xf7\x7d\x5f\xbd\x5c\xb8\xb0\x8a\xb8\xab\x2f\x22\x34\xb6\x63\x0d\xa4\xe9\xd2\x54\x41\x6a\xfe\xbb\x93\x8f\x6e\x93\x12\x65\xb1\x2f\x49\xce\xcc\x99\x8f\x33\xd3\x8c\x4e\x53\xa9\x35\x21\xd3\xc4\x5f\x68\xf9\x17\x54\x3d\xf0\xe3\xc8\x7b\xa9\x35\x5a\x56\x6f\x90\x8d\xf1\x2d\xe8\x03\x0c\x0a\xb1\xad\x61\xfb\xbb\xb1\x9b\x10\x77\xf0\x82\x3e\x05\xc5\xd3\x9a\xed\xdb\x53\x67\x0f\x01\x4c\x41\xb9\x83\x77\x10\xde\xd7\x22\xde\x3f\xb2\xfe\x09\xa0\x3d\x0c\x9c\x89\xa5\x90\x82\xde\xdf\xed\x2c\x09\x4f\x53\x58\x96\xd1\x03\x7b\x16\xa0\x4c\x3f\x03\x93\x2d\xd0\xd2\x01\xe5\x8e\xab\x51\xeb\x82\x3e\xda\x7e\x9f\x2e\x32\xc7\xda\x8b\xb7\x56\xeb\x4b\x72\xea\xcd\xeb\xc0\x59\x3b\xb0\x4e\x6b\x8c\xe8\xef\x84\x54\x55\xd5\xc1\xd0\x31\xde\x10\x23\xcd\xec\x82\x70\x48\x4f\xa5\xc5\x28\x36\x2d\x9d\xa2\xb4\xd6\x3f\xd6\x98\x90\x3c\xff\xc7\x41\x34\x2a\xcf\x83\x68\x0e\x3a\xb7\x89\x34\x2d\xcb\x7e\x2a\xc3\x8f\x0e\x06\x90\x35\x28\xad\x73\xfb\x50\x74\xec\xb7\x36\xdd\x62\x73\x51\x5d\x83\x73\x11\x69\x6b\x50\x1b\xdd\x24\xa3\x7c\xcb\x5f\x98\x79\xa0\xed\x5a\x2b\x57\xf6\xe1\xe3\x08\xae\xbd\x8c\xee\xeb\x57\xe8\x98\x59\x51\x83\x6e\x69\x85\x24\x67\xaf\x9c\xf3\x0e\x64\x3b\xbe\xda\xe5\x71\x57\xbb\x3f\x33\x1a\xed\xe9\xed\x00\x35\x77\xab\x8d\xde\xa7\x97\x25\x04\xb6\x88\xb3\xaf\x99\x51\x05\xfd\xed\xcd\xfa\x7a\x6c\x99\x4e\xb4\x6a\xd7\xec\x78\xe4\xb2\x55\x73\x07\xf3\x9b\x90\xeb\xbe\x01\x41\x3f\xdd\x4a\xe3\x69\x95\xc1\xf3\xaa\xef\x3a\x90\x23\x29\xec\xf7\x59\xa4\xcf\x62\x59\x83\x25\x85\x99\x41\x38\x20\x9b\xc2\x0c\x61\xd3\x99\x9b\xda\xa4\xec\x66\x18\xb7\xcc\xc8\x73\x89\xd9\x57\x21\xce\x27\x67\x2b\x73\xb0\x1c\x41\xd4\xdf\xc4\x8b\x22\x21\xea\x05\x0a\xb4\x5e\x69\xfe\x5f\x3e\xf7\x6f\xb2\xc1\xad\x3b\xc9\xee\x21\xba\x6c\x22\xfe\x8a\x87\x7e\x64\xc2\xcc\x50\x80\x5c\xb1\xf0\xef\x5c\xcf\xe5\x86\x75\x90\x1c\xca\x32\x89\x28\x44\x42\xc9\xf3\x35\xfa\xbe\xfb\x74\xdb\x31\xf0\x05\x79\x08\xde\xde\xf7\x05\x00\x00")
 
 func assetsFullMdBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,12 +111,12 @@ func assetsFullMd() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/full.md", size: 1430, mode: os.FileMode(420), modTime: time.Unix(1441249321, 0)}
+	info := bindataFileInfo{name: "assets/full.md", size: 1527, mode: os.FileMode(420), modTime: time.Unix(1792297081, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assetsIndexMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4b\x8f\x1b\xc7\x11\xbe\xf3\x57\x14\xb4\x97\x25\x30\x1c\x2a\x02\x74\x11\x1c\x07\x4a\x9c\x87\x03\xcb\x32\x24\x25\x97\x40\xc0\x14\xbb\x6b\x38\xed\xed\xe9\x9e\x74\xf5\x90\xcb\x18\xfe\xef\x41\x75\xf7\x3c\x48\x6f\x36\x08\x22\x9f\x96\xdb\xaf\x7a\x7c\x5f\x7d\x55\x73\x07\xdf\x60\x44\x78\xe7\x35\x59\x86\x8f\x14\x4e\x46\xd1\x66\xf3\x77\x0a\x6c\xbc\x7b\x03\x3f\xfd\x54\x97\xdf\x3f\xff\xbc\xd9\xdc\xdd\xdd\xc1\x27\x3f\xec\x2c\x9d\xc8\xc2\x07\x62\x3f\x06\x45\xbc\xd9\xec\xf2\x0b\xf0\x71\x20\x65\x5a\xa3\x30\x1a\xef\x18\x76\xf0\x8f\x7d\x9f\x9e\xfe\x7c\x5f\x7e\x6c\x61\x07\x6f\x81\xd7\xe7\xc0\xb7\x40\xa8\x3a\xd0\xe2\x4a\x3a\x06\xa7\x6c\x14\x0c\x03\x9e\xd0\x58\x3c\x58\x02\x8c\x80\xd0\x94\x87\xf6\x5f\x2d\xc7\xbf\xde\x7f\x55\x2e\x7c\xdd\x00\x39\x3d\x78\xe3\x22\xdc\x53\x7d\xac\xab\xd9\x85\xbd\xef\xfd\xb0\x3f\xbd\xfe\x7c\xdf\xc5\x38\xbc\xd9\xef\xe5\xfe\x2e\xef\xed\x38\x47\x5e\x07\x62\xc2\xa0\xba\x5a\x75\x7e\xa8\x49\x8f\x37\x97\xb7\xdb\x5a\xa2\xfd\x40\x83\xcf\xe1\x05\xf9\xf5\xf9\x3e\xff\x95\xe0\x3e\x75\x86\x17\x1f\xb8\xf3\x67\x86\xd8\x11\xfc\xd9\x44\x48\x87\x4c\xf4\xe1\x02\x3e\x2c\xff\x19\x62\x38\x90\x71\x47\x10\x37\x48\xc3\xe1\x02\x51\x9e\x99\xbc\xca\x99\xff\xc6\xb4\x2d\x05\x72\x8a\x18\x7e\x4f\xf1\x4c\xe4\x0a\x70\x9b\x8d\xec\xc9\x23\x79\x35\x9e\xfd\x94\x41\x96\xec\x4e\x59\xf5\xe1\xea\x48\x20\x8b\x91\x74\xde\x64\x50\xe8\xe0\x40\x70\x32\x74\x26\x3d\x25\x5b\xf9\x7e\xc0\x40\xeb\x6c\xc3\x6f\x96\x7c\xa7\xdf\xab\xad\x57\xab\xad\x57\x4f\x82\x31\x3d\x98\x12\xfa\xba\x7e\x59\xbf\xdc\x0f\xa4\xd9\x51\xdc\xbf\xaa\x5f\xd5\x2f\xff\x47\x78\xfe\xdb\x73\x09\x30\xc9\xde\x1f\xbc\x8b\xe4\x22\x38\x3a\xfa\x68\x12\xf1\x36\x9b\x4f\x1d\x4d\x49\x06\x1e\x87\xc1\x87\xc8\x82\x8b\xd8\x71\x51\x10\x49\xc4\x0c\x85\xe9\x60\x1c\x9c\x30\x18\x3f\x32\xb4\x3e\xf4\x18\x19\x46\x4e\xc0\x99\x7e\xb0\x04\x6a\x6d\x24\xd9\xa8\x21\xd9\xc8\x6f\x93\x9e\xaf\x61\xa0\x37\xc2\xa4\xbf\x7c\x7a\xf7\x1d\xec\xa0\x89\xf4\x18\xf7\x5d\xec\x6d\x23\xc5\x84\xe1\x41\xfb\xb3\x9b\x37\xfa\xb2\x20\x9b\x7f\xfd\xf8\xfe\x7b\xd9\xc0\x61\xb0\xa5\x82\xf6\x3f\xb2\x77\x4d\x0e\x47\x13\x9b\x30\x1b\x9a\x40\x0d\xf4\xcf\x91\x58\x1c\x20\x13\x3b\x0a\x42\x31\xa6\x98\x42\x14\x76\x36\x6f\x95\xa2\x21\x36\xd0\x11\x6a\x0a\x10\x7d\x5a\x56\x3e\x04\xe2\xc1\x3b\x2d\x07\x7b\xd3\x53\xbc\x0c\x94\x78\x74\x01\xd4\x69\x15\xa1\xc9\xb6\x1a\x18\x30\x60\x4f\x71\xb9\xff\xb7\x0f\xdf\xd5\xf0\x27\x1f\x80\x1e\x51\x12\x54\xc1\x81\xac\x3f\x4b\x59\xcb\xf6\xfb\x77\xef\x7f\x80\xd3\xeb\x25\xbf\x73\xea\x49\x4b\xae\x53\xf2\xf3\xe3\xeb\x64\x5d\x57\x74\x02\xfd\x77\xf9\xd4\x6f\x25\x83\xff\x47\x81\xff\xe2\xad\xed\x35\x1a\xcf\x58\xee\xf5\x97\xb2\xdb\xeb\xed\x02\xf3\x33\x16\x05\xf4\x2f\x65\x53\xde\xda\x6e\x36\x1f\xa6\xfc\x17\x01\xc7\x40\x10\xd1\x58\x2f\x8c\x9a\x38\x61\x0d\xb9\x28\x00\x8a\x44\x04\x02\x7a\x1c\x48\xc5\x7c\x60\x64\x4a\x87\x26\x44\x2b\x40\x16\x4a\xaa\x60\x0e\x22\x6c\x82\x7e\x0d\xdf\xfb\x48\xf9\x3e\xfb\x7e\x39\xcc\xa0\x3d\x38\x1f\xa7\x72\x01\xb4\x76\x2a\x98\x5c\x47\x89\x00\x85\xd9\x85\x43\x9a\x5a\x1c\x6d\x9c\x56\x87\xe0\x4f\x46\x93\x86\x73\x47\x0e\x5c\x61\x7b\x4f\xb1\xf3\x5a\xa4\x50\xfd\x52\x06\x52\x10\x23\x93\x2e\x4a\xf1\x44\x2b\x5b\xf7\xba\x3b\x39\x23\x8e\xe4\x7a\x5b\xbb\x34\x89\x5c\xe1\xf5\x17\x25\xe2\x56\x02\x36\xe2\xbc\x44\x87\x0c\x28\x12\x7f\x99\x94\x67\x08\xde\xb7\x25\x40\xa9\x64\x71\x09\xb4\xe1\xc1\xe2\x65\x2a\xf2\x95\x4e\x5f\x37\x60\xe3\x00\xe1\x4c\x87\x82\x6d\xba\x1b\x48\x1a\x81\xd4\xf9\xea\x1a\x3a\xbd\x97\x77\x65\x65\x64\x0a\x5c\xc3\x5b\x06\x1e\x55\x57\x81\x89\x70\xa0\xa3\x71\x0c\x67\x13\xbb\x5b\x83\x73\x43\xd7\xf2\x08\x20\x04\x2a\xcd\x4c\x44\xa2\x82\xd6\x5b\xeb\xcf\xb9\xf7\x21\x58\xc3\x51\x82\x89\xd2\xf7\x19\xee\xcf\x9d\x51\x5d\xee\x8e\x9c\x63\xb7\xc6\x3d\x08\xe5\xd2\x60\xb0\xe0\xca\xdb\x1a\xfe\x28\xaa\x91\x37\x98\x54\x09\x50\xd9\x51\x53\x66\x4c\xde\xca\xa4\x1c\x32\x01\x92\x47\x93\xd1\xd6\x90\xd5\x0c\xf7\x78\x44\xe3\xaa\xe7\x6d\x25\x6d\x4b\x2a\x25\x97\x2a\x78\x91\xc2\x62\x88\xfe\x05\x18\x97\xf1\x33\xde\x55\x60\x5a\x49\x10\x3d\x1a\x8e\x5c\x09\x94\xeb\x80\x33\x8f\x6f\xfc\x71\x17\x60\xd5\x51\x8f\xd7\x60\x49\xca\x17\x57\x7a\x1c\x06\xe3\x8e\x5c\x42\x98\xd7\x8d\x3b\xf8\xd1\xe9\x25\xcb\xb9\x96\xd1\xb2\x9f\x4b\x24\x39\x95\xaa\x70\xe5\x69\x76\x6d\x74\xba\xce\x93\xcc\x54\x2f\xb3\x32\x8b\x29\xc0\xe3\x31\xd0\x71\x9e\xde\xd6\xf7\xf1\xe0\xc7\x78\x8b\x7e\xc6\xef\x2c\x45\x6f\xdc\x03\x9c\xfd\x68\x45\x0c\x84\x43\xed\x68\x33\x57\x9f\x63\x59\xa9\xba\x49\x89\x73\xe5\xcd\xba\xfc\xeb\x56\x5f\xaf\xb7\xdb\x29\x67\x99\x40\x8c\x3d\x5d\xc7\x9c\xd7\x57\x52\x50\xc3\xb7\x0e\x5a\x54\xb1\xba\xdd\x91\x04\x6b\x0a\x46\xe6\x3c\x6d\x02\xa9\x68\x2f\xd0\x06\xdf\xa7\x83\x53\x4c\x65\x6c\x28\xc0\x83\xea\xbc\x49\x18\xa6\xe4\x96\x1e\x9d\xa6\xf0\x8c\xbc\x0f\x47\x74\xe6\x5f\xd9\x9b\xd2\xf2\x59\xc6\x3c\xcc\x50\xa0\x8a\x23\xda\x79\x1a\xe3\x89\xd2\x78\xf0\x27\x2a\x48\xdf\xca\x8b\x83\xb7\x3f\x7c\x2b\xe8\x72\x9a\x8a\x92\x8b\x19\xc7\x91\x69\xa7\x90\x69\xb7\xf8\x57\x7a\x82\x98\x0e\x14\x83\xa1\x13\x55\x92\x34\x45\xcc\x55\x72\xb1\x68\xd1\x4c\x1d\x09\xff\x3f\xeb\xd1\x6d\x6e\x2f\x70\x36\xdc\x4d\x3c\x90\xde\x98\x39\x90\xba\xe4\xaf\x8b\x7f\x6a\x8d\xdb\x0a\x46\x67\xcd\x43\xee\x6e\x83\x88\xa3\x1f\xd9\x5e\x56\xbd\xad\x34\xaa\xea\x2a\x91\xc2\xec\x48\xaa\x73\x46\xa1\x85\x24\xd5\xfd\xd4\x5e\xe7\xac\x49\x7a\xa4\x4d\x51\xeb\x43\x7a\xbc\xd4\x19\x04\x42\x6d\xec\x05\x7a\x54\x9d\x71\xb4\x2b\x09\xcd\x1f\x43\x4e\x03\x3d\x76\x38\x72\x34\xa7\xd5\xe4\x34\x17\xe5\x73\x8a\x9f\xe9\x15\xe7\x4f\x39\x7f\xf8\x91\x54\x4c\xc5\x8e\xa2\xe0\x37\x77\x1b\x87\x3d\x35\x15\x34\x45\xc5\x9b\x0c\xe9\x22\xe1\xcd\x18\x6c\x23\x50\x9d\xc9\xda\xc2\x1e\x0c\x01\x2f\xe2\x48\x93\x55\xbc\x29\xca\x5c\x6c\x19\x97\x07\xcf\xb2\x59\x8e\x5f\x79\x90\xd5\x2c\x1b\x4f\x06\x9b\x95\x48\x26\x1f\x56\x46\xb2\x6a\x17\xcf\xd2\xcb\xc9\xf7\x06\xe4\x7a\x5a\x2c\xb1\x2c\x31\xc8\xbc\xe2\xb0\x3f\x98\xe3\x98\xc1\x34\x5a\xa6\xfe\x36\x0b\x72\x3e\x1e\x7d\x21\xfd\xe2\x90\x0c\x31\xee\xc8\x4f\x87\x53\xdc\x78\x2a\x9c\xb4\xb5\xe4\xf2\x3a\x96\x46\x06\xeb\xe2\x7d\x23\xd3\xba\x0c\xf1\x0d\x70\xc4\x38\x32\xdc\x1f\xfd\x89\x82\x43\xa7\x28\x23\xb6\xad\xd6\xd9\x4e\x96\xcb\x20\xd4\x4c\xdd\xb2\xfc\x2f\xbd\x08\x9a\x17\x2f\x9a\x6d\x05\x8d\x25\x77\x8c\x9d\x98\x1b\x02\x29\xb3\xc2\xb2\x61\x85\x96\xe6\xcb\x32\x79\x4d\x93\x95\xdc\x7f\xd9\x4c\x8d\x35\x47\x51\xa2\x4e\xfd\xe4\x2a\xc8\x8c\x67\xce\x7a\x11\x96\x52\x9e\xdc\xdd\xa8\xbe\x71\xa0\x2f\x0e\x7b\x29\x0d\x7b\x01\x15\x08\xd3\xf7\x88\xd8\xce\x9a\xe3\xdb\x35\x0b\xfd\x40\x21\xb7\xc0\x2a\xab\x51\x69\x8f\xf9\xa2\x77\xe2\x28\x3a\x57\x3e\xbc\xd2\xb8\x1a\xd0\xf1\x2c\x25\x5c\x6f\xfe\x1d\x00\x00\xff\xff\x1b\x10\xcb\x2b\xef\x10\x00\x00")
+var _assetsIndexMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x5c\x7b\x73\xdb\xc6\x76\xff\x5f\x9f\x62\xab\xcc\xcd\x88\x33\x10\x25\x2b\xf5\x6d\xeb\x5a\x49\x1c\x5b\x49\x9c\xca\x96\x23\x29\xe9\xb4\x99\x8c\xb1\x04\x96\xe4\x46\x20\x40\x63\x01\xd1\xbc\x1e\xf7\xb3\xf7\xbc\xf6\x01\x92\x92\xe5\x36\x99\x9b\x2b\x93\xc0\x3e\xcf\xe3\x77\x1e\x7b\x96\x5f\xa8\x17\xba\xd3\xea\x55\x53\x9a\xca\xa9\x2b\xd3\xde\xda\xc2\xec\xed\xfd\x6a\x5a\x67\x9b\xfa\x89\xfa\xf0\x61\x2c\x9f\x3f\x7e\xdc\xdb\xfb\xe2\x8b\x2f\xd4\x75\xb3\x3c\xac\xcc\xad\xa9\xd4\xa5\x71\x4d\xdf\x16\xc6\xed\xed\x1d\xf2\x08\xea\x6a\x69\x0a\x3b\xb5\x85\xee\xa0\x87\x53\x87\xea\xb7\xa3\x05\x0d\xfd\xfb\x81\x7c\x18\xc1\xc3\x67\xca\xa5\xed\x54\x33\x55\x46\x17\x73\x55\xe2\x52\xa8\x99\xba\xe5\x49\x95\x75\x4a\xdf\x6a\x5b\xe9\x49\x65\x94\xee\x94\x56\xb9\x0c\x74\xf4\x34\x36\xff\xfa\xe8\xa9\x74\xf8\x3a\x57\xa6\x2e\x97\x8d\xad\x3b\x75\x60\xc6\xb3\x71\x16\x96\x70\xd4\x2c\x9a\xe5\xd1\xed\xe3\xdf\x0f\xe6\x5d\xb7\x7c\x72\x74\x84\xfd\x0f\xf9\xdd\xa1\xe3\x9d\x8f\x5b\xe3\x8c\x6e\x8b\xf9\xb8\x98\x37\xcb\xb1\x29\xfb\x8d\xce\xa3\xd1\x18\x77\x7b\x69\x96\x0d\x6f\xaf\xc5\x4f\xb0\x3b\xfa\x17\x37\x77\x3d\x87\x35\x87\x35\xb8\x79\xb3\x72\xaa\x9b\x1b\xf5\x83\xed\x14\x35\xb2\x5d\xd3\xae\x55\xd3\xc6\x6f\xd6\x38\x35\x31\xb6\x9e\x29\x5c\x86\x29\xd5\x64\x0d\x5d\x60\x18\xbf\x2a\xa6\x3c\x8e\x70\x69\xa6\x40\xee\x67\xe9\x48\xd2\x0e\xba\x01\x7d\x70\xa6\x49\xab\x6b\xa0\x26\xcc\xd0\xe9\x99\x9a\xd9\x5b\x53\x2b\x3d\xed\x4c\xab\x74\xad\xf2\x6f\x73\x65\x81\xae\x9d\x53\xf9\x21\x8e\x92\xab\x66\x89\x5c\xc8\x54\xbe\xd0\x0e\x5a\xe5\x38\x7d\x69\xa6\xba\xaf\xba\x31\x88\x04\x50\x56\x57\x30\xe1\xd4\x21\xa3\x70\x02\xa7\x17\x26\x5d\x41\x01\xe3\x4e\x4c\xb2\x8a\xa6\x2e\x0c\x8e\xe2\xcc\x52\xb7\xc0\x63\xd8\x19\xf4\x5b\xa8\x95\xed\xe6\xaa\x68\x16\x30\x51\xa6\x90\x3b\xb2\x06\x85\x1c\x71\xc0\x92\x19\x34\xe8\x27\x63\x68\x72\x84\x0c\x38\x2c\x27\x73\x9b\xf2\xe9\x5b\x5e\x62\x56\xa2\x00\x36\xcb\xec\xf6\x64\x7c\x32\x3e\xce\x33\xd5\x35\x38\x2e\xcc\x66\x60\x77\x87\xcb\xb6\x99\x01\x27\x9d\x2a\xe6\xba\x9e\x01\x75\xf5\x4c\xdb\xda\x21\x03\x2a\xa3\x1d\x2c\xb2\xa9\x8d\x1b\xab\x33\x94\x3a\xd8\x19\xd2\xb0\x98\x9b\xe2\x06\xdf\xf4\x9d\x27\x50\xb3\xaa\x55\x69\x5b\x53\xe0\x2e\xc7\x20\xb6\x55\x53\x10\x29\xc2\xce\x99\xb8\xb0\x53\x8d\x43\xc3\x4e\x6f\x8d\x5a\x6a\xd8\x64\x64\xca\xb4\x6d\x16\x34\xda\xaa\x69\x6f\x88\x12\xad\x31\x9e\x55\x53\xdb\xd2\xaa\xa6\x99\x72\x0d\x3d\x19\xb4\x5a\xf4\xf0\x16\x28\x9b\xae\x8d\x7a\x6a\xe9\xd4\x40\x97\x76\x65\x9d\xa1\x19\x98\x46\x0a\x89\x50\x37\x5d\xe0\x47\x8d\xff\x57\xa6\x6d\x41\x22\x60\x5d\x55\x33\x9b\x99\x72\x0c\x82\x6a\x7c\x0f\xe1\x6b\x58\x0d\x0d\xa1\xcb\x12\x69\xe8\xc5\xd1\xd8\x36\x68\xa5\x76\xaa\x77\xbd\xae\x32\xea\x36\x1c\x84\x96\xc4\xe2\xb2\xd5\xef\x1d\xf4\x01\xb5\x87\x21\x49\x12\xb0\x39\xce\xb6\xa9\xa8\x4b\x53\xba\xda\x74\x47\x27\xe3\xaf\xc6\xc7\xdf\x0a\xaf\x03\x82\xec\x7e\x3d\x1a\x65\x6a\x35\xb7\xc0\x4f\x91\xc6\x1e\x57\xbe\x82\xb5\xa0\x00\x03\x7f\x12\x44\x31\xef\x01\x7e\x3a\x53\x66\xc0\xe8\xa2\xea\x4b\xa4\x37\x4b\x8f\x75\x08\x5b\xae\x87\x61\x60\x8f\xbf\x1d\x89\x4c\x0d\xa7\xbc\x73\x7d\x9f\xd1\x7a\x34\x56\xaf\xf4\x72\x09\x33\x3b\x62\x50\x6b\x6a\x50\x2a\x07\x7a\x57\x54\x30\x44\xc9\x58\x87\xc4\xa9\x6c\x7d\xb3\x83\xcc\xa2\x83\x53\xe6\x23\x36\xf4\x28\xca\x00\x0a\xdb\xe4\xcd\xc1\x58\x22\xce\x20\xb7\x08\x6f\x75\x27\xe8\x8c\xf3\x0a\x0b\x5c\xe8\x9d\x08\x37\x0a\x41\x65\x41\xdf\x68\x35\x9b\x50\x27\xa0\xc4\xb8\xff\x23\x34\x83\x2e\x88\x4c\x43\xfc\x06\x2a\x02\x6a\xad\xe0\x1f\xda\xd0\x12\xd4\x97\x00\x00\x1e\xc2\x8c\xb2\xaa\x04\xc9\xb6\xc0\x7e\xce\x23\xdf\x81\xf6\xdf\xe8\xee\xf4\x29\x8f\x97\x20\x7f\xc6\x7c\x57\xb9\xee\x72\x1a\xd4\x4f\x79\xf5\xe3\xb3\x47\x99\x82\xf9\x9c\x9d\x54\xb0\xc1\xc9\x04\xa4\xc3\x6a\x12\x85\x06\x85\x04\x26\x31\x81\xfd\xf9\xc9\xf1\xa3\xbf\x1f\x1e\x7f\x75\x78\xfc\x28\xc7\xd7\xc9\xf7\xeb\x47\x27\x4f\x8e\x8f\xe1\xbf\xff\xce\x45\xea\x1c\x20\x4b\xd1\x31\xd4\x57\xc3\x5d\x12\xbb\x58\x17\x49\xb7\x44\x81\x3b\x0b\x1c\x0c\xa2\xef\xf7\x19\xa5\x05\x80\x0d\xb7\x17\x27\x05\xd2\x3f\xa0\x15\x58\x28\x12\x09\x40\x70\x5b\x5b\xe6\xf4\xd4\x56\x86\x71\xa1\x35\x5a\x20\x09\x57\x35\xc3\x05\x4e\xfe\xa0\x85\xcb\x42\x65\xd9\x80\xda\xa8\xc6\xb0\xd0\x7a\x0b\x96\xb2\x38\x80\x47\x47\xb4\x60\xb4\xa9\x79\x53\x95\x3b\xa6\xb6\x75\x4a\x05\x82\x33\xc0\xb2\x4d\x0c\x22\x01\x94\x05\xe0\x62\x41\x99\x18\xab\xc1\x9a\x78\x61\x1d\x6a\xc1\x57\x27\xf0\x80\x30\xab\x00\xb9\x06\x96\xb6\xe6\x5d\x6f\x48\x64\x79\x1c\xde\x75\x01\x03\xa7\x38\xc6\x72\xc0\x8c\x4b\x15\x45\x33\xff\x72\x60\x53\xee\xa7\xa0\x09\xbd\x96\x25\x06\x1b\xc7\x65\x5f\x81\x35\x8c\xb0\xaf\x2d\x89\x68\x66\x2d\xc4\x9e\xa1\xfa\x80\x3a\x83\xb2\x5c\x33\x71\x05\x64\xa2\x87\xa0\x8b\xc2\x2c\x45\x70\x48\xa9\x6f\x75\xd5\x47\x92\x81\x0c\x83\xf4\xe1\xee\xe1\xd3\x49\x8e\x34\x81\x46\x30\xa8\x4b\x6d\x9e\x47\x7e\x26\xcc\xaa\xf1\xbb\x4f\x11\x2e\x4c\x30\xd0\x50\xb1\xc3\xbb\xa0\xee\x64\x00\x5e\x2c\x68\x8f\x44\xd2\x1e\xb1\x3c\x7e\x7e\xa7\x91\x5f\xb3\x8b\xd4\x05\x0e\xab\x9f\x74\xdd\x6b\xc0\x80\x47\xc0\xcd\x8e\x4d\x61\xd1\xb7\x00\x8b\xe8\x4d\x18\xa6\x7f\x8e\x72\x27\xc4\xe8\xd0\x71\x19\x8a\xee\x4f\x57\x17\xaf\x37\x40\xce\xb3\x96\x49\xc9\x44\xc9\x53\x98\x44\x64\x0a\x3a\x21\x5c\x12\x25\xf3\xc3\xea\xae\x6b\xed\xa4\xef\x0c\x4d\xa4\x41\xa2\x4d\x05\x78\xe1\x51\x02\x65\xac\x34\xae\x68\xad\xb8\x51\xdd\x7a\x09\x2a\x52\x99\x7a\xd6\x91\x13\x56\x80\x1a\x74\x2d\xf8\x1f\xc4\x8e\xcf\xf5\x67\x8f\x9e\x76\xd8\x16\xfe\xa5\x79\xbf\xf6\x10\x70\x8f\x9f\xbb\xc9\x8c\x16\xe4\x4d\xfe\x79\x6b\x4b\x3f\xc0\x2e\x7b\x7a\x5f\xfb\xd1\x28\xe8\xe0\x06\x81\x60\x33\x40\x49\x7c\x99\xd0\x81\x69\xd5\xf9\x7d\x3e\x74\x97\x7e\x77\xe2\x9b\xb1\xff\x46\x54\xf3\x5c\x28\x83\x78\xdc\x05\xb0\x04\x44\x0b\x5d\x1a\x32\x3d\xe8\x68\x20\x8f\x58\xe7\x75\xdf\xcd\x9b\x36\x63\xa0\xc7\x25\x83\xd1\x75\x7a\x06\x0c\xf3\x9b\x9b\x54\xa8\x23\x68\xf7\x5c\x3a\x0d\x63\x93\x48\x24\xa9\x28\xc9\x0e\x43\x3d\xad\x12\x51\x80\xe5\xd4\xd3\x67\xc0\x6c\xd1\xe7\x1f\xaf\x5f\x9d\x67\x60\xfb\xdb\x9b\x12\xdd\x4b\x9c\x95\xe4\x76\xda\xb4\x0b\xdd\xb1\x51\x86\x7e\x93\xde\x56\xe2\x47\x03\x27\x44\x58\xf1\xdd\x70\xd7\xd1\x78\x8e\xd5\x7f\xe2\x4e\x35\x44\x1c\xba\xaa\x9a\x95\x2a\x2a\xd0\x1b\x75\xe0\x8c\x08\xff\x61\x09\x30\x33\xf7\xde\xfe\x08\xc6\xae\xd6\xbc\x41\x6c\x38\xc4\xcb\x44\x44\x85\x2a\xde\x91\x9e\x18\x58\xa8\x61\x6f\x9e\x5a\x6e\xb1\x85\x9d\xc8\xc1\xa0\xe2\x2a\xbc\xb0\xd3\x29\x80\x21\xec\xc9\xa9\xef\x4c\xb7\x32\xe0\x3a\x73\xe0\xb9\xb7\x87\xef\x70\x74\x7e\x8a\x08\x26\xa2\x21\x4a\x27\x38\xd1\x0e\x9a\x90\xcb\x0d\xb3\x08\xfa\x89\xe3\x77\x6b\xcd\xca\x3b\x50\x79\x40\xa8\x44\xee\xd4\xa3\x28\x79\xf4\x39\x79\x75\x92\xbc\x3a\xd9\x19\x4c\xfa\x01\x29\x20\x7c\x3c\x3e\xde\xc4\xbb\xcf\x0c\x2f\x3f\x35\x1c\x05\x9c\xdf\x19\x67\x4b\x44\x4c\xe4\x09\xcb\x08\xa1\x81\xcb\xbc\x88\x7a\xb3\x82\x02\xd1\x02\x17\x97\xad\x5d\x20\x9c\xde\x98\x35\x34\xea\x6b\x0b\x66\x31\x43\x21\x33\x76\x56\xe3\x53\x1a\x04\xa3\x84\xba\xaf\xaa\x21\x46\x91\x08\xd6\xa5\x79\xef\x8d\xfa\xca\x70\x38\x80\x6e\x52\x6b\x16\x0d\x2a\x1a\x02\x1b\x8b\xfd\xb6\x6d\xfb\x3f\xc8\x3d\x2b\xce\x2e\x04\x87\xa5\x75\x18\xbc\xd1\x28\x8c\x27\x65\x14\xa4\x4c\x64\xce\xa0\xdb\x91\xc8\x97\xf7\x26\xb8\x83\x0c\x88\x6b\x99\x40\x7c\x22\x02\x93\x0d\xe1\x3d\xc8\xb8\xef\xcb\xa3\x6e\xf6\x65\x1e\x64\xde\xa2\x04\xba\xe5\x48\x55\x76\x32\xd0\x95\x97\x06\x42\xc7\xe4\x65\x17\xf4\xca\xb3\x90\x02\xb4\x19\x04\xac\x33\x04\xa5\xdc\xc1\xc6\xa1\x03\x10\x86\x96\x81\xe4\xdd\x64\x3b\x3b\x35\x2c\x39\x61\xc6\xb1\xba\x94\x20\x02\xdf\x86\x40\x02\xd6\xad\x43\x78\x81\xde\x98\xe7\x48\x88\x6c\x3d\x98\xd4\xa0\x37\xed\x86\xff\x1e\xe2\xb4\x9c\x9e\x43\xa4\x9d\xcb\x2b\xfc\x48\x7b\xc1\x0f\xb4\x2c\xfc\x00\xd4\xba\x7d\x9b\xb4\xa0\xef\xdc\x8c\xed\x36\x3d\xe0\xe6\xb0\x83\xaa\x5f\xd4\xce\x2f\xbc\xdc\x96\x6f\x71\xa3\x50\xa6\xd9\x3d\xf3\x1b\x49\xfd\x53\xcd\x42\xa9\x2b\x1f\xea\x82\xa8\x5a\xb6\xc5\xc1\x6b\xb4\xb3\x96\xc5\x89\x0d\x54\x18\x87\x80\x0c\x08\xb2\xac\x34\xa8\x27\x84\xf8\xcc\x1e\x81\x92\x56\xd6\x85\x54\xa0\xbc\x40\xe7\xfc\xc2\x80\xe8\x95\xd1\xb7\xe8\x58\xc9\xee\xb7\xf7\x67\x16\xcb\x6e\x0d\xfc\xfb\x9e\xbb\x90\x2e\x55\x4d\x73\x03\xd6\xe5\xc6\xb0\x9b\x48\xaa\xe5\xa7\x21\x97\xb2\x87\xe8\x9c\x1c\xd8\x15\xe3\x39\x88\xd8\x14\x94\x1f\x2d\x81\x2b\x40\x7f\x71\x91\x5e\x09\xe3\x7e\x8d\x50\x6c\x10\x1e\x8a\xa8\x91\x27\x6d\x49\x16\xa6\xe2\x00\x3b\xf0\x5a\x17\x3a\xf5\x0e\x21\x62\x12\x8a\x06\xe7\xf5\x5d\x8f\x9d\xc8\x67\xc1\x05\x74\xb6\x90\x88\x1f\x06\x88\x82\xe6\xec\x02\x34\xbd\xf5\xa4\x86\x77\x89\x17\x20\xc9\x08\xc0\x3c\x30\x36\xc9\x20\xb4\xb7\x45\x93\x74\xe7\x01\x71\x87\xb8\x9a\xb9\x9d\xc1\x62\xc6\x4a\x28\xe7\xe9\xbf\x3d\x32\xa6\x39\x3c\xc5\xc4\x49\x14\xd6\x26\xae\x32\x61\x48\xdb\x54\x8c\x21\x25\x3c\x2a\x3a\xca\x6e\xe6\xd2\x37\x57\x07\xfc\x86\x92\x5e\xa3\xc4\xfa\x4b\x03\x9c\x10\xa4\x19\x22\xf6\x0a\x3c\x2f\x5d\xb9\x26\x20\x2d\x9b\xc2\x20\x99\x29\x4d\x45\xd1\x36\x85\x8f\xc5\x32\x6f\xa6\x10\x64\x94\xd6\xb1\xcc\x0f\x56\x16\x55\xf9\x1e\x35\x26\xf3\x5b\xad\xf4\x1a\x48\x01\xeb\xb2\x84\xc4\xe4\x35\x45\x20\xa4\xc4\x16\xf8\x28\x8e\xb3\x2e\x20\xb4\x28\xc2\x16\x80\xa3\x40\xef\x42\x21\x16\x03\x80\x19\xbd\x20\x13\xd0\x2f\x30\xae\xf0\x9e\xed\xd9\xf5\x39\xa0\x5f\x53\x60\x12\x08\x94\xf4\x3b\x68\x46\x31\xa0\xc7\x49\x5b\x83\xce\x59\xf2\xa4\xcc\x7b\xa0\x18\xbe\x23\x53\x0a\xc8\x05\xe6\x06\xa3\x24\x89\x2e\x74\x6a\x36\xda\x54\xd9\xf1\x81\x38\xd4\x9a\x3c\x67\xef\xf2\x35\xa9\x7e\xaf\x50\x74\x28\x3f\xea\x43\x73\x40\x5c\x33\xc3\x5c\x25\x78\x1d\xf9\xc4\xce\xe0\x7b\x8e\x43\xd4\xba\x6d\x1b\x34\xff\xec\x7f\x67\x08\xdf\x85\x25\x28\x6b\x50\xec\x75\x85\xae\x9e\xe8\x0a\x29\xe4\xc4\x14\x9c\x4a\xf1\xe2\x4e\x89\x80\x08\xec\x62\x02\x31\x83\x81\x6a\x06\xea\x47\xe8\x72\x1b\xed\x85\x79\xdf\x99\x34\x3a\x0d\x9b\x06\x40\x8d\xbb\x64\xbf\x0b\x40\x4a\xf4\xb4\xf5\xfb\x42\x52\xe0\xc6\xe1\x09\x2f\x7a\xac\x9e\x37\x0e\x04\xd7\x16\xd1\x24\xa1\xb3\xc6\xd2\x3f\x31\x3b\x66\x4a\x14\x83\xb5\x60\x87\x4b\xc0\x1e\x6c\x14\x06\x21\x34\x61\x08\xe2\x7d\x69\x41\x26\x42\x4c\xcc\x73\x38\xcc\x22\xde\x22\x46\x51\x47\x30\x4a\x7d\x0d\x24\xca\xfb\x5a\x8c\x7f\x2e\x98\xd2\x1a\xd1\xc7\xd4\x0a\x3f\xcc\xa8\x2f\xc0\x3d\xc1\xb0\x39\xa7\x35\x77\x76\x62\x2b\xdb\x41\x04\x80\xc9\xa4\xab\x9f\xcf\x3d\x62\xb3\x57\x4f\xaa\x84\xc8\x43\x92\x36\xd1\xce\x44\x8f\x10\xfd\x03\xf0\x0a\xc5\x1b\x1c\x38\x21\xd1\x8c\xb9\x77\x55\x2e\x4e\xc7\xb6\x4f\x77\x57\x18\xfb\x15\x84\xb1\xdc\xe7\x14\xfa\x7f\x59\x5a\x8d\xc9\x9e\x53\xf0\xbd\x3b\xcc\x33\xbf\xab\x1e\x10\x0a\x7f\x72\x0c\x4c\xdc\x5c\x01\x7d\xcc\x02\xc8\x24\xd6\xa2\x6c\x9b\x65\xd0\x29\x61\x18\x5b\x4d\x92\x19\xca\x01\xc8\x07\x0e\x7a\xd6\x04\x89\x94\x85\xc1\xed\x06\xbd\x94\xa4\x02\xe5\x0a\xc0\x07\x8b\xe6\x25\x7f\x71\x76\x75\x7d\xf9\xcb\xf3\xeb\x97\xbf\x9e\xe5\xe4\xaf\xa3\xb3\x83\x42\xe1\x00\x72\x61\x18\x32\x83\xe2\x53\x47\xef\xdf\x73\x05\xa8\xdc\xf6\xf5\xf6\xc2\x71\x25\x53\xa0\x3e\x82\xcc\x00\x1c\x62\xe0\x8c\x56\x1a\x1e\xc2\x12\x5e\x5f\x5c\xab\xd7\xbf\x9c\x9f\x7b\x8f\x20\xe0\xbd\xf6\x90\x8c\xdb\x5f\x30\xfa\x68\xdf\x28\x76\xcb\x76\xed\xeb\xf2\xe5\xd5\x7f\xfc\x57\xd8\x91\x84\x20\x57\x64\xf7\xd4\x8b\x17\xe7\x28\x5d\x6f\x98\xf6\x9b\x42\x56\x00\xd0\x75\x26\x71\x38\x01\xc6\x12\x47\x6f\xe0\x1f\x27\xc1\x89\x77\x99\xc4\x77\x40\x68\xad\x1a\x5d\xc6\x50\xe4\xfe\x08\xb8\x2c\xab\x07\xc7\xf4\xd0\xf6\xae\xf0\x1d\x5e\x51\x06\x50\x3c\x29\xd4\x5c\xda\x0e\x59\x90\xd4\xff\x87\x70\x10\x66\x03\x3d\x5d\x73\xda\x4a\xdc\xf8\xc8\xd7\x19\x40\x53\x4b\x3d\xd1\x15\x4d\x68\x95\x1e\x0f\x5d\x10\x4c\x7b\x5d\x14\x8f\xa0\x5f\x8a\xc3\x16\xd5\x4e\xc4\x3d\x31\xca\x19\xe6\x75\x90\x7e\x79\x54\x01\xf4\x18\x17\x6b\xf9\x00\xff\xd8\x8e\xbc\xcb\xa6\xd5\x05\x3a\x90\x98\x83\x5d\x38\xd2\x60\xa1\x4f\x7e\x27\x11\xbe\xf1\x0a\x26\x9d\x49\xb9\x5a\xce\xf4\x93\xd7\x89\x53\x63\x74\x34\x60\x67\x30\xb7\x51\x02\x25\x91\x93\x52\x12\x0d\xd0\x2d\x86\x71\xba\x3d\x38\x79\xfc\x78\x44\x27\x6a\xaf\xd6\x40\x9b\x4c\x5d\xd0\x74\x34\x28\xd2\x0a\x4f\x54\x71\xaf\xe1\x68\x02\xd5\x93\x66\x03\xd4\x9b\x20\x9a\xc2\x78\x8e\x16\x06\x70\x79\xde\x80\x82\xb7\xf2\xfd\xde\x85\x12\x9d\xe7\x48\xe5\x3a\x86\xde\x15\x76\x07\xc8\x96\x25\xc3\x23\x80\xc7\xc8\x1b\x14\x4c\xea\xff\x44\xfd\xcb\xdf\xff\x15\xe1\x04\xd6\x4a\x99\xc4\xb8\xfc\x7f\x3e\x3e\x3e\xc6\xaf\xc9\x36\xfe\x8d\x9f\xc4\xdd\x88\x2e\x9d\x81\x33\xd7\xad\x0f\x2f\xe9\xdc\x0b\xd4\x63\x6e\x97\x10\xe2\x6b\x00\xe8\x05\x05\xf4\xfc\xc9\x3b\xa4\xe2\xda\xef\xd0\x17\xef\xa3\x27\xc2\xe9\x52\x50\x5f\x6c\xa6\x24\x1e\xa2\x4d\x60\xce\xa2\x36\xf9\xec\x21\xa1\x6f\xea\x17\xfa\xa4\xfd\x0f\xad\x5e\xce\x6f\xed\x3f\x40\x4e\x1b\xf6\x23\x5e\x19\x68\x6b\xc1\x4b\x33\xad\xec\x04\x64\x2e\x5f\xf0\xd3\x7c\x44\xa2\x08\x31\x43\xdd\xf5\x0b\x90\xc6\x0e\x5c\x80\x4f\xa8\x2c\x2c\xc8\xc3\xbf\x8c\x72\x97\x06\x6f\xb7\x1c\x8d\xfe\x3d\x09\x41\x88\x58\x7e\x7d\x25\x2f\x0e\xf5\xb5\x6c\xf5\xaa\x26\x3f\xb1\xa6\x68\x1b\xf6\x39\x83\x68\xe1\x0d\x67\x02\x62\xf0\x8f\xf4\xe6\x84\x00\xe1\x40\x12\x68\x31\x82\x66\x72\x20\x15\x62\x69\xca\xf6\x4f\x93\x18\x06\xc1\x1d\x10\x8e\x32\x07\x14\x6b\xd2\xcc\x98\x7c\x16\x1f\x47\x4e\x20\x64\x6d\x21\x98\x42\xb9\x2e\x24\x55\xc4\x67\x32\xfa\x50\x8e\x88\xd1\x63\x03\x2b\x41\x78\xc0\xa2\x92\x93\x27\x26\x92\x2d\xd2\x83\x44\x80\x0d\xe6\xf3\x66\xc9\x11\x72\x14\x98\x83\x47\x09\x2e\x8d\x58\xce\x24\x3e\x7d\x00\x67\xa8\xe1\x29\xe7\x3c\xbf\xc4\xd1\x4f\x4f\xee\x63\xcf\x8e\xe6\x09\xe8\x02\x9b\x30\x5d\x23\x69\xc9\x8a\x5d\xfa\x48\x50\x7f\x0e\x31\x5c\x7f\x48\x63\x56\x86\x49\x14\x01\x9c\xc9\x2b\x51\x3e\xb9\x82\xc9\xc1\x09\x71\x99\x23\x12\xc9\x75\xbb\x9d\x02\x82\x04\x01\x76\xf1\xb0\xa2\xc1\x3f\x81\x72\xa8\x37\x10\x49\x3b\xc6\xfe\xbb\x75\x14\xe5\x11\x1e\xce\x50\x4f\xe8\x44\x32\xee\x22\x11\x94\xc6\x87\x97\x2c\x2c\xbc\x4a\x46\x29\x87\x2e\x34\x6f\xf3\x0f\x98\x95\xce\x23\xc0\x57\x4b\x76\x19\x4f\x1a\x3f\xad\xdd\xb4\x8e\x23\x3c\x68\xff\x06\x85\xf3\x54\xb2\xc7\x5f\x76\x8d\xff\xf8\x60\x53\xba\x39\x54\xd9\xf6\xb3\xb7\xe6\x3d\xd8\xa4\xbe\x35\x38\x60\x01\x8b\x7b\xeb\xc0\x12\xdd\x25\x10\x0f\x1f\x01\x65\xe4\x32\x92\x0b\x77\x3d\x6d\x30\x59\xcb\xd6\xd9\x58\x36\xa6\x94\x97\xf1\xc8\x48\x5e\x3b\x95\x14\x70\x50\x8a\xf1\x1b\x52\xd0\x45\xe3\x8a\x5f\x83\xb1\x60\x77\x1e\xc1\x3a\xff\xfe\xf2\xe2\x55\x8e\x8e\x7b\xef\x00\x07\x7e\x59\xa2\x32\x3d\x3a\xa6\xc1\x86\xc7\xc8\x89\x89\x6b\x4d\xd7\xb7\x68\x52\xfa\xba\xc2\x02\x8a\xbc\xb2\x74\x64\x42\xf5\x0c\x02\xa4\xc2\x34\x9c\xd6\xeb\xb2\xcf\xcb\xe1\xca\xab\xcd\x93\xe3\x87\x71\xb3\x06\x5d\x98\x4f\x9a\xd6\x89\x76\x79\x46\x42\xe0\xd9\xc8\xe2\x12\x1b\xe0\x38\xac\xc0\xdd\xfa\x9c\xa2\x08\xf5\x39\xac\x0a\x54\x62\x6f\x2f\x9c\xaf\xd3\x09\xba\x87\xb9\xa2\x6d\x5c\xac\x94\x80\xee\x3e\xcf\xcc\x7b\xab\xb8\xf7\xe0\xa4\x27\x64\x18\xa1\x1f\x8c\xc9\x7b\x46\x1c\x6e\x75\xed\x28\x14\xac\xd6\x12\x89\x71\xb1\xc7\xc9\xe4\x04\x9b\xbc\x39\x7b\x71\x05\x62\x82\x1f\x2f\x5e\x5d\xbc\xa1\x47\xcf\x2f\x2e\xe1\xd1\xee\x73\x20\x99\xfb\x81\x07\x41\xbb\x24\xdc\x8f\x70\xff\x89\x0e\x08\xf2\xc3\x1a\xa2\xbc\x9e\xc5\x4c\x28\xac\xf9\xd6\xa2\x1c\xcb\x91\x2c\x40\x4e\xb1\x2e\x04\x31\x16\xa1\x9a\x21\x95\x6a\x8a\x5b\xb1\xb1\xe4\x3c\xe4\x0c\x82\x84\x8a\x71\xaa\xee\x17\x13\x10\xfa\x74\x84\xd0\x3b\x1c\x33\xd3\xfc\x43\x06\xd1\xb1\x3e\xe3\x51\xa8\x5e\x61\x0e\xfb\x50\xdc\x0f\x37\x70\x25\xbc\xd2\xd8\xd6\x47\x06\x98\xd9\x90\xf4\xfa\x5d\x39\x6a\xe0\x75\x4c\x08\x96\xa6\x65\x17\x70\xe8\x34\x70\x40\xef\x51\x77\x0a\x1b\x40\xff\xaa\x1b\x38\x0d\xd1\x1d\xde\x72\x45\x7c\x35\x05\x2f\x5a\x3d\x6f\xb0\xea\x0a\xa5\xf8\x47\x3c\xca\x81\xd0\xb2\x82\x56\x78\x60\xe3\x03\xdc\x78\x3e\xc2\x96\xdb\xcb\x25\xe1\x05\x27\x4f\xac\x4b\x52\xa7\x02\xab\x42\x94\x3b\x65\xec\x13\x35\x75\x59\xca\x13\x3c\xe7\xe1\xb5\x50\xf6\x8c\x8b\x01\x47\xde\x64\x3b\x03\x31\x53\xc9\xaf\x3a\xdd\xce\x0c\x58\xe5\x08\xc5\x7e\x1d\x43\x01\x8c\x87\x20\x08\xb6\x9f\x6a\xb3\x21\x9e\x20\x06\x49\x72\x9f\x2a\x9d\x04\x82\x1c\x96\xb6\x21\x81\x38\x84\xe8\x6b\xff\x4d\xa3\xc7\x1c\xd9\x22\x12\x64\x29\xfc\x09\x24\xe5\x8c\x10\x8c\x01\xf1\xca\x61\xd7\x1c\x2e\x74\xbd\xa6\xb4\x87\x96\x2d\x47\xf5\x88\x6c\x58\x70\x9c\x0c\x52\x83\x51\x0e\xef\x5f\xda\x91\x22\xe0\x20\x38\x18\xbc\x95\xb1\x06\x6d\xe2\x58\x44\xef\xe1\x68\xe9\xac\x83\x48\xcf\xef\x12\xf3\xee\x20\x7b\x10\x00\x6d\x6e\x38\x6c\x15\x74\x1c\x2b\x26\x04\xea\x52\xab\xcd\x2e\x5e\x14\x2a\xa1\xb1\x70\x83\xf4\x0e\x9d\x0d\x5f\x92\x86\xb1\xa1\x24\x17\x52\xdf\xb0\xc2\xd4\x11\x25\xfb\xfe\x60\x7f\x66\x35\x97\x14\xcd\x26\xcd\x24\x46\x06\xe1\xf2\xae\x5e\xa4\xc3\x13\xf4\x69\x24\x2b\xc4\x59\x34\xce\xef\x91\xfb\x40\x69\x0f\x91\x28\x8d\xc7\x26\x18\x34\xe5\x09\x0f\xb0\xda\xd1\xe7\x09\x47\x99\x72\x0b\x70\x80\x8c\x4f\xb2\xb9\x24\x35\xc8\xa0\x41\xc9\x41\xe7\xbd\x5f\xce\x06\x6e\xe0\x3e\x31\x63\x98\xc8\x13\xbb\xb1\xd2\x6d\x1d\xe0\x4f\xe4\x6e\x4b\xae\xea\x70\x66\xdb\x72\x8a\x05\xa5\x0a\x28\xe5\xd6\x87\x42\x5e\x34\xb4\xae\x37\xd1\x3c\x33\xb1\x36\x6a\x50\xda\x6e\xc3\x7e\x6c\x42\x57\x16\xad\xe3\xf3\xab\x5f\x33\x9f\x13\x01\xca\xd1\x69\xb2\xd0\x9e\x97\x03\x0d\x24\x1f\xe6\x33\x00\xe8\x12\x04\xdb\xe2\xb3\x00\x08\xb7\xee\x06\xbc\xd3\x0e\x1c\x13\xcc\x0c\xfb\x44\x01\x47\x86\x34\x43\x1e\xf3\x5b\xf9\x67\xe8\xfa\xae\xb4\x18\x65\x01\x1e\x02\x02\x77\x76\x0e\xe8\x20\x19\xd8\x69\x2a\x5d\xa1\x46\x4e\xa4\x0f\x2b\x38\x41\x5a\x5e\xbe\xbe\x3a\xbb\xbc\x56\x2f\x5f\x5f\x5f\xa8\xf1\x78\xac\xae\xce\xce\xcf\x9e\x5f\xe7\xca\xf9\xec\x56\x84\x3f\xe1\x0c\x0f\x4e\x89\x52\xa9\x67\x1b\xc4\x53\x41\x6a\xb2\xc4\x5d\x8a\x55\x92\xe9\x20\x4e\xd0\xa8\x4b\x7d\x66\x72\xfc\x60\xe0\xe8\x64\x87\xba\xc1\x60\xbf\xa4\x2c\xa0\x0e\x47\x76\xa9\x28\xae\x5a\xdb\x75\x86\x82\x33\x64\x53\xec\x33\x01\x0b\x23\x87\xc7\xe4\x38\x6e\xc8\xbd\x77\x27\x63\x1e\xc4\x03\x00\xd7\x3d\x49\x94\x82\x02\xcc\x99\x3b\xaa\xa5\xe0\x0c\x1c\x3e\xbc\xbe\x78\x71\x91\x4b\xdc\xbd\x9d\xfb\xf1\x09\x66\x12\x35\xed\x08\x44\xf0\xfb\x8b\x17\xe7\x31\x68\x4f\xeb\x0b\x5f\x92\x56\xec\xed\xbd\x69\x1b\x20\xd5\x42\x12\xd1\x98\x55\xa9\xb8\x4c\xcc\x17\x38\xc5\xd2\x33\xa9\x3c\xdb\x0e\x66\x62\x06\x32\xb6\xa8\xc1\xdf\xbd\xa9\xd1\xe8\x73\xf5\x8e\x1c\x6d\x44\xac\x48\xa2\x1c\xe0\x20\xa6\x6d\x24\x51\x94\x78\x1d\x8c\x55\x68\xa8\x83\xf3\x91\x7d\x76\x68\xc3\xfa\xff\xe0\xf0\x85\x9b\xdf\x15\x9a\xf0\xdb\x50\x11\x88\x13\x62\x0e\x08\x9f\xf2\x8e\x68\x37\x78\xbe\x37\xab\x9b\x36\xc6\x74\xbe\x5c\x81\x15\x88\xdb\xcf\x35\x41\x00\x9e\x06\xd8\x6e\x0d\x7e\x0d\x15\x14\xa3\xad\x11\x81\x63\x5b\x63\xeb\x69\x83\x68\x0b\x68\xd3\xe3\x59\xb3\xf8\xcf\x5e\x90\x19\x0e\x2b\x13\xcb\xa5\x45\x21\x98\x45\xe1\x4c\x9a\x7b\xa1\xb3\xe7\x3d\x44\x30\x92\xc4\x21\x39\x2f\xf7\x85\x3b\x3e\xb1\x4b\x65\x15\xe0\xf4\xfb\x93\xfd\xad\x93\x64\x09\x80\x45\xe1\x37\x7c\xa8\x50\xc1\x2e\xf5\x7c\xe1\x10\x7f\x77\x6d\x93\x70\x92\x9b\x7f\xf3\x0e\x62\x15\xf0\xf1\xdc\x4e\x97\x3c\xb4\x21\xd7\xf9\x6d\x53\x70\x0d\x51\x61\xd8\x11\xbf\xef\x35\x87\x8b\xae\xaf\xb0\xb4\x5b\x77\x28\xb3\x18\xc1\xe3\x54\x4c\x03\x8c\xd7\x74\x1d\x92\xde\xd4\x86\x2b\x07\xf9\x98\x70\x85\x21\x15\xb9\xeb\x58\x02\x4d\x5b\x71\x9b\x7b\x71\xde\xac\xf0\x3c\x42\x08\x60\x11\xd5\x2d\xde\x77\xfe\xcf\x8e\xcc\x8d\xad\x4b\x4a\x8d\xf9\xd7\x92\x73\x41\x59\xe0\x63\xf0\x51\x52\xb3\xe8\x2b\x73\x71\x44\x8f\x57\x69\x7d\x0f\xaa\x62\x28\x20\x47\xc1\xbc\xa3\x62\x5c\x3b\x5f\xd4\xfc\xcb\xe5\x79\x16\xa8\x41\x71\x05\x83\xec\xe3\xe3\x3b\x42\x58\x96\x17\x3a\x58\x56\x3f\xf3\x29\x65\x38\xa1\x7f\x88\x8c\xf0\xc9\x66\x52\x4c\x9a\xd4\x07\x8a\x5c\x60\x93\x35\x8a\x05\x7d\xd8\x29\x16\xbe\x49\x82\x2b\xa7\x8a\x03\xaf\x71\x1a\xa8\x6d\xb7\xfb\xdb\xc9\xf1\xdf\xbe\x7a\x01\x7f\x37\x5b\xa3\xb8\x3c\xa3\xe5\xad\x63\x8d\x65\xb2\x38\x36\xd1\xa7\xc8\xa3\x7f\xa2\xbf\x5f\xd3\x1f\xfa\xf8\x94\xfe\x9c\x32\xdb\xfe\x07\xf8\xe9\x0f\xe8\x46\xbe\xe8\x6c\x02\xaa\xe8\x06\x55\xf2\x3c\x1e\xbc\xe6\x14\x3d\xfe\xad\x31\x10\xc2\x0e\x38\x79\x4d\xf9\xe7\x70\xd1\x83\xce\x38\x4f\x95\xe4\xcd\xa9\x95\xe4\x1d\xbe\x56\x27\x8f\x1f\xf3\xd4\xc1\xfe\x9c\x42\x74\xdd\x1b\x5f\xa4\xf4\x96\x52\x8d\xa7\x6a\x0a\xae\xa7\x01\xb3\xf2\x2b\x3b\x9c\xb4\x02\xb7\xd4\x3e\x99\xf2\xae\x6f\x42\x19\x40\xca\x95\xf6\xc1\xe5\x2b\xa4\x23\xf8\x21\xd1\x10\x6a\x09\x8b\xa7\xf7\xb4\x62\x29\x6c\x61\xe7\x91\x8e\x2a\xd0\x75\xe4\x6e\x94\x7f\xc4\x8f\x7e\x2b\x42\x17\xda\x02\x10\x36\x18\x0e\xba\x34\x12\x8f\x95\x46\x34\x26\xa5\x6a\xdf\xde\x98\x35\x8f\xe0\x79\x2e\xf5\x09\x49\x0a\x91\xd7\x3e\x16\x0d\x4b\x1b\x97\x6f\x27\x6b\x69\x1f\x63\x6b\xcb\x67\x0d\x89\x19\x1b\xf9\x38\x84\x0d\xd5\x56\x0f\xff\x82\x84\x8a\x1d\xc6\xcd\x33\x91\x5d\x87\xeb\x01\x84\xea\x26\x3d\x83\x6e\xa4\x2a\x33\x70\xc5\x4b\x85\x88\xc0\x53\xf5\xe8\xf8\x38\x57\x65\x43\x3d\x3b\x81\xbb\x1d\x27\x24\xc1\x41\x15\x39\x67\x9d\xc4\x13\x24\x56\x7b\x51\xbe\x9c\x0e\x18\xa5\xc2\x8c\xb0\x2c\xe3\x8c\xb3\x5f\x50\x28\x24\xf8\xb0\x8f\x6b\xda\x7f\xa2\xf6\x79\x29\xfb\x99\xda\x6f\x96\xf8\xfd\x6b\xfc\x48\xa1\x0d\x7c\x03\x09\xfd\x88\x30\xd7\x2c\x09\x48\x40\x8d\x86\xe9\x66\xae\x19\x40\x2d\x09\x57\xda\x78\x5e\x37\x98\xaa\x2e\x61\xac\xdf\xc0\xb5\xfc\xfd\x23\x72\xf8\xc3\x7e\xd3\xc6\x07\xa4\x01\x1f\xf6\x61\xff\xf0\xec\x03\x3c\xfb\xf8\x31\xf7\x57\xbf\x30\xd7\xf0\xf3\x39\x3b\xe8\x29\x3c\x6d\xc1\x12\xe9\x84\x34\xf7\x78\x44\x69\x92\x77\xd5\xf0\x82\x04\x9e\x47\xf9\xda\x73\x68\x24\x64\x8b\x2e\xda\x01\x8d\x14\x82\x1d\x3c\xf0\xb2\x9c\x98\x97\xf2\x95\xa5\xe1\xaa\x96\xd7\xd0\x01\x40\x9e\x0a\x54\x7e\x38\xbb\xf6\x25\xf8\x4c\x13\xe2\xc0\xa4\x29\xd7\x11\xbf\x89\x7d\x94\xf3\xa6\x2e\x6f\x2e\xae\x42\x9f\x31\xdf\x9e\x9b\x1a\x96\x21\xed\xab\x1c\x28\x59\x24\x27\xb1\xc1\xf7\x94\x68\x3a\xe8\xf9\xc0\x49\x63\x77\x54\x32\x40\x96\xe3\xe6\x96\x54\x00\x9a\x2f\xc5\xe6\x35\x4d\x97\xba\xeb\x8c\x11\xee\x00\x8d\xe7\x88\x8e\x22\xf1\xfb\x01\x3b\x02\x02\x1b\x23\xd9\x3d\x15\xde\xe6\x31\xfa\x1e\x56\xac\x85\x35\xfa\xa4\x3d\x63\x25\x6d\x41\x86\xe7\x61\xa8\x4f\x98\x30\x19\x85\xf2\xc7\x66\x80\x01\x19\x3a\x57\xa4\x10\x78\x09\x90\xd6\xe7\x95\x37\xf3\x55\x4f\xe5\xf7\xb1\x42\x5e\x9e\x5c\x37\xde\x0f\x0f\x86\x3b\xb1\xb8\xdb\x26\x96\xef\xe4\x6c\x99\x58\x56\xd7\x48\x11\x50\x10\xf1\x35\xf7\x03\x71\xe0\xd9\xe0\x92\xd3\xbe\xdf\x14\xdd\x36\xa4\x4b\x15\xe2\xf8\x0f\xaf\x28\xca\x0d\xa1\x50\x87\xcf\xb3\x3d\xd9\xdb\xcb\xf3\x5c\x64\x77\xef\xc3\x9e\x52\x0f\x98\x1d\xbc\xde\xfd\x91\xc2\xc6\x4a\x45\x72\xc3\xab\x4d\xff\x2a\xb4\x52\x9e\xe4\xfe\xab\x22\x96\x85\x2f\x08\xfd\xe1\x4b\x22\x61\x1f\x98\xb3\x2c\x85\xf2\x85\xa3\x41\xf9\xf2\x11\xff\x17\x7a\x06\x61\xfc\x10\x8a\x2c\x76\x76\x65\xb6\xc8\x43\xef\x22\x7d\x1c\x0c\xc6\xff\xe2\xdf\x8f\x7b\x1f\x91\x46\x8c\x11\xcf\xc1\x64\xe3\xb0\xb5\x99\x35\x9d\x25\xd5\x94\x80\x9e\xbd\x79\x7f\x2e\x9f\x5c\x04\xc3\xa8\x49\x6e\x7d\x49\x28\x0a\x2c\x47\x45\x6f\x7a\x17\x2a\xd2\x7b\x0a\xae\x9c\xc5\xd0\x86\x0a\x77\xc2\x24\x3a\xd6\x1b\xc5\x33\xff\x50\xc8\xde\x9a\x27\x78\x55\x96\x8e\x22\x0f\x41\xf8\xcd\xfb\xee\x68\xde\x2d\xaa\x1c\x6f\x0b\xfb\x1c\xab\x7f\xb1\x90\x07\xf8\x92\x30\xe3\x90\xab\xed\xe4\x8a\xf0\xd1\x1f\x00\xda\xf8\x0e\xb3\x15\xbe\x4f\xe1\x6e\x73\xde\x21\x58\x6b\xce\xd6\x70\x5d\x4f\x48\xd6\xfa\xbb\x40\x72\xb2\x42\x37\x52\x3b\x7f\x1d\x55\xe5\xcf\x08\xfe\x72\x35\x07\xc1\x33\x6d\xbc\x57\x00\x12\xe2\x96\x4d\x4d\xe5\x29\x0b\x0b\x70\x28\xe5\x59\x68\xb2\x43\xd1\xca\xf6\x69\xb2\xf4\x07\x75\x19\xab\xef\xb1\x7a\xf7\xbd\x46\x9a\x65\xb0\x16\x2c\xc8\x17\xd1\xa7\xa3\x80\xdb\xc7\x91\xe4\x81\x1b\x72\x0a\x44\x19\x4d\x1a\x3c\xa5\xdf\xf0\x16\xf3\x20\xf5\x81\x44\xfd\x7f\x5c\x6a\xde\x1a\x6b\x34\x64\xd0\x3d\x33\x2f\xca\x3f\x6b\xde\x45\x39\x8a\x9c\xbf\x67\x46\x94\x83\x3f\x6b\x4e\x1c\x6b\xb4\xb7\x77\xb9\x79\x2d\x12\xe3\x13\x6d\x2b\x0a\x88\xbd\x4c\x54\x36\xd6\x36\x61\x03\x7f\x85\x94\xb2\x01\xce\x48\x60\xc2\x1c\xcd\x10\x3b\x7d\xb1\x5e\xc9\xdc\x1f\xab\xd7\xe0\x9b\x72\x7f\xd7\x2c\x62\x63\x68\xd9\x48\x19\xeb\x92\xf3\x82\xe0\xe9\x0e\x8a\xe2\x49\x00\x44\xb2\xad\x2f\x14\xe5\xaa\x28\x79\xba\x6c\x9b\x5b\x5b\xfa\x24\x6b\x2d\xd2\x0e\x42\x39\x6f\x28\x2d\x5e\x6c\x23\x03\x6d\x02\xaf\xc8\x02\xd6\xbe\x47\x2d\xe0\x1c\xcc\x96\x35\x63\x3f\xc1\xcf\x07\x9b\x45\x16\x0d\x52\x33\x83\x2b\xff\xe9\x6f\x02\x7c\x81\x6d\x70\xf1\xac\xa3\xe9\x36\x7c\x18\x24\xba\xf0\xa7\x0a\xef\x88\x6f\xf4\x61\x49\x26\xe7\xa2\xa8\xb8\x71\xed\x01\x0c\x68\xd5\x4c\x85\x28\x61\xdf\xa5\x75\xcb\x4a\xaf\x43\x12\x29\xde\x07\x19\xfe\x50\x01\x39\x2c\x2b\x33\x11\x79\xa0\xbe\x5c\x1c\x47\xde\x60\xec\x06\x14\x3c\x6a\xb8\x2e\x0a\xa9\xdc\x02\x2b\x9f\xb1\x27\x88\xce\x0c\x88\xc4\x6c\x70\x82\xbb\xeb\x87\x0f\xa4\xba\x3b\x9a\x1c\xb6\xc3\xe1\x70\x8c\xe2\x07\x5f\x33\x21\x8c\x3b\xf0\x77\x51\xdb\x5b\xe3\x78\xef\x72\x58\x1f\x92\xa0\x22\x0b\x6e\x98\x1d\x75\x72\xe2\x3c\x28\x21\x90\x43\xdd\x34\xef\x41\x2b\xf2\x93\x8a\x88\x1c\xd0\xe5\xfa\xec\xfe\xb9\xbe\x97\xdb\x0c\xfe\x4c\x75\x3f\xa4\x9f\xf6\x15\xa6\x8c\x90\x7f\x94\x9b\xb3\x98\x45\xe5\x92\x41\xbe\x2e\x97\x6e\x98\x65\x7f\x63\x3d\xf5\x5a\xca\xd9\x87\xcc\x72\xb1\x9a\x7f\x70\x3c\x49\x5b\x08\xcf\xb7\x03\x23\xa9\xab\x76\x4d\x50\xab\x8c\x6b\x5c\x51\xfd\xe2\x4a\x79\x69\xd0\x55\x7c\x56\xaf\x63\x01\xcd\x1d\x55\xe6\xcb\xb5\x0e\x09\x09\xd2\xfe\x7a\x82\xb1\xd4\x06\xf7\x99\x7f\x2b\x04\x0a\x3c\xb4\x5e\xf9\x0a\x4c\x90\xa1\x69\x5f\xb1\xac\xde\x27\x65\x52\x00\xbe\x71\xc7\x84\xb7\xc3\x09\xbc\xa5\x1c\xf9\xf8\xdf\x37\x18\xdc\x99\xf7\x0c\x6c\xe2\x51\xfe\xce\x1f\xd1\xf0\xf7\x94\x30\xf3\xf0\x67\x5a\xa1\x1d\x43\x72\x9c\x7a\xf7\xf4\xf1\xb4\xfa\x2f\x58\xc8\xee\xc1\x7d\x3e\x55\xee\x16\x21\x41\xe3\x85\x81\x69\xbc\xb1\x20\xf9\x21\xf2\x1e\x06\xc9\x75\x3c\x4e\xe8\xdd\xe6\xe9\xe3\x3d\x25\xa6\x41\x1b\x79\x62\xb9\x98\x34\x9c\x58\x74\x20\x3d\x0c\xcf\xee\x1d\xd7\xd6\xb7\x4d\x45\x17\x58\x6c\x97\xde\x5a\xd8\x2c\x4b\x92\x4a\x7c\x46\x18\xaf\x27\xa1\x5b\xe7\x86\xba\x45\xf7\x0b\xb6\x94\x4a\x8a\x38\x70\xc9\x6e\xbb\x94\x83\x4c\xc3\xa0\x94\x43\xfd\x52\xfb\x3b\x32\xe1\x07\x25\xc4\xb0\x64\x77\xdf\x12\x0a\x6e\x94\x2f\x0f\x91\x0c\x20\x8d\x0f\x8a\x12\x9c\x1a\x3a\x19\x17\xe7\x90\xac\xf5\xa4\x6d\x56\xa8\x40\x68\x8c\x13\xd6\x6d\x78\x88\x63\x31\x6a\x7e\x1c\x36\x6c\x61\xd4\xbf\xd6\xb8\x81\x87\x34\xf2\x90\x94\x9c\x9e\x0c\x20\xc5\x85\xab\x76\xfe\x48\x4f\xbd\x84\x75\xe9\xa2\xcb\x36\xdf\x50\xc5\xa0\x69\x2d\x5e\xca\x08\xca\x1e\x4e\xb7\x22\x23\xc8\xb9\x17\x5c\x55\xb0\x4a\x4b\x10\x49\xd8\x25\x6e\x33\xfd\x18\x10\xb3\xa3\x69\x67\xba\xb6\xff\x90\x1a\x7f\x9f\x53\x37\xb5\xff\xb9\x13\x58\x08\x04\x9b\x21\x0d\xe1\x3c\xe0\xd0\x69\x94\x00\xe9\xa6\xf5\xae\xd5\xb3\x37\x2f\x51\x0e\x1d\xc5\x2e\xb4\x44\x06\x2d\x80\xbc\xc3\x42\xc3\x9f\xb8\x3e\x71\xd3\x70\xea\xd6\x40\xa4\x0f\x4b\xcb\xfc\x1d\x16\x0e\x45\xc5\xd4\xc7\x0b\x77\xe5\x7d\xe6\x7e\x93\xb6\x98\xc6\x70\x73\x2f\x07\x28\x63\x2c\x03\xc9\x55\xc6\xbf\x8a\xff\xe4\xad\x8e\xf0\x2e\x67\xd0\x0c\xbc\x6b\x86\xa1\x5a\x72\x37\x24\xc4\x5f\xd9\x80\x90\xe4\xdc\x99\x62\x5e\xe3\xf5\x2b\x45\x9e\xd0\x22\x5e\xc6\x10\xaa\x49\xe5\x4c\xcb\x45\xfe\xd1\x8c\x51\x60\x6e\x61\x92\x05\xd8\x16\x5b\x9b\x43\x21\x68\x30\x32\xe6\xfd\x5c\xf7\x8e\x4e\x73\x36\xee\x79\x08\x1a\xdd\x45\x61\xc1\xd1\xf0\x8b\x52\x7c\xa1\x7f\x78\xe5\x33\xe9\x9b\x63\x50\x3c\xcc\xe1\x0e\x4a\x55\x55\xde\xb7\x15\xa5\xfe\x56\x06\x4b\x53\xd9\x04\xb7\xad\x5e\xa7\x75\xa5\xe2\xf8\xc8\x5c\xfe\x67\x16\x3a\x9f\xdb\xa2\xe6\x3b\x2e\x9d\xf2\xe4\xbe\x8c\x29\xcd\x0e\x0f\x26\x61\x44\xca\xe3\xd5\x43\xc9\x3c\x73\x40\x4f\x69\x29\xde\x4b\xdc\x03\x1d\x28\xea\xc5\xc4\xce\x7a\x66\x26\x5f\x94\x9b\xae\x93\x83\x31\x68\xc3\x42\x1f\x17\x84\x71\x05\xe5\x44\x77\x6d\x47\x96\xb1\x6b\x3b\x6c\x3d\x02\x2d\x77\x67\xba\x25\x83\x24\x29\x6c\x6f\xb1\x0e\x66\x78\x92\x54\x6b\xa0\x35\x73\x6c\x94\xa5\xd4\xe6\x4a\x7f\x49\x81\x7b\x67\x54\xbe\xd3\x49\x63\xbe\xbf\x4f\xc9\xea\xdd\x69\x74\x9a\x93\x53\xe9\xbe\x33\x06\x43\x49\xf0\x91\x1f\xe7\x1b\x35\x3f\xbc\x6b\xae\x48\x49\x37\xe9\x8f\xa1\xf8\x62\x2c\x67\x15\x59\x3d\xe3\xb5\x16\x71\xaa\x80\x64\xe5\x1a\xda\xa1\x6a\x00\xf1\xa9\xc8\x9f\x62\x7c\x98\x9b\x31\x07\xf8\x9a\x48\x61\x48\x7c\x3a\xa9\x86\x12\xcb\xcb\x1d\x41\xe8\xa9\xf4\xa4\x96\xf4\x08\x45\x90\x58\x28\x18\xa0\x04\xbd\xb4\x58\x05\x9a\xed\x74\x41\xd3\xec\x25\x1a\xb7\x66\x81\xc7\xf8\x65\x92\x73\x86\x78\x06\x25\x9e\x30\x29\x2d\x70\x1c\x94\x80\x91\x7e\x71\x39\x66\xf8\x8d\x93\x24\x89\x51\xab\x1c\xe2\x58\x3c\xc1\x49\xb2\x18\xe1\xda\xe9\x1d\xd5\xd9\xc3\x8c\x64\x3c\x3f\x20\xee\xc9\x5e\x72\xae\x0a\xf5\xd7\xac\x25\x8b\x3b\xbc\xf0\x17\x13\x20\x9f\x74\x31\xb7\xdd\x30\x04\xc3\x2f\x79\xed\xc9\xd1\x58\x16\x8e\xdd\x65\x1d\xf1\x5c\xfc\xcf\x1b\xd3\x7b\x7f\x48\xf4\xcd\xbb\xe2\xda\xdf\x93\xe7\xd8\x2b\xa7\x36\xfe\x77\x4a\x24\x09\xbc\x33\xa3\xfb\x16\x7f\x83\x29\xdf\x38\x92\x09\xbf\x50\x93\xc2\x81\x58\xcc\x78\x0b\x59\x3c\x95\xbb\xcf\xb7\x92\x4c\xf3\x56\xc5\x5b\x02\x9d\x0c\x6f\x74\xcf\x8a\x0b\x3d\x72\xc9\x53\x4a\xfa\xf8\x87\xc6\x47\xbd\xe2\x3a\x51\x92\x5a\x93\x79\x61\x5a\x84\xf4\x71\x7e\x26\x52\xd5\xc4\x30\x0d\xb9\x5d\xdd\xfa\x1b\xd7\x13\x5d\xdc\x70\xc9\x17\x39\x02\xe8\x79\x51\xee\xa2\x34\x45\xc3\x69\x37\x62\xdb\x78\xef\x7f\x01\x66\x28\xcc\xdc\xa0\x50\x00\x00")
 
 func assetsIndexMdBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,7 +131,7 @@ func assetsIndexMd() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/index.md", size: 4335, mode: os.FileMode(420), modTime: time.Unix(1478800664, 0)}
+	info := bindataFileInfo{name: "assets/index.md", size: 20640, mode: os.FileMode(420), modTime: time.Unix(1792300265, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -134,7 +156,7 @@ func assetsModelsMd() (*asset, error) {
 	return a, nil
 }
 
-var _assetsReposMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x08\x4a\x2d\xc8\x2f\xce\x2c\xc9\x2f\xca\x4c\x2d\xe6\xe2\xaa\xae\x2e\x4a\xcc\x4b\x4f\x55\xd0\xab\xad\xd5\x55\xa8\xae\xd6\x0b\x0d\xf2\xa9\xad\xe5\x52\x50\x50\x50\xd0\x55\x70\x2a\x4a\xcc\x4b\xce\xb0\x02\x09\x43\x98\x70\x19\xe7\xfc\xdc\xdc\xcc\x12\xb0\x0c\x84\x19\xec\xe1\x68\x88\x26\xab\xe0\x92\x58\x92\x8a\xa4\x24\x24\x33\x37\x55\xcf\x27\x3f\x39\x31\x07\xae\xd0\x2d\xb5\x24\x39\x23\x35\x05\xac\x08\xcc\x46\x51\x53\x5d\x9d\x9a\x97\x52\x5b\xcb\x05\x08\x00\x00\xff\xff\x04\x14\x1d\xe3\xb3\x00\x00\x00")
+var _assetsReposMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x53\x56\x08\x4a\x2d\xc8\x2f\xce\x2c\xc9\x2f\xca\x4c\x2d\xe6\xe2\xaa\xae\x2e\x4a\xcc\x4b\x4f\x55\xd0\xab\xad\xd5\x55\xa8\xae\xd6\x0b\x0d\xf2\xa9\xad\xe5\x52\x00\x02\x5d\xa0\xca\x34\x2b\x90\x18\x90\xae\xad\xad\xae\xce\x4c\x53\xd0\x73\x49\x4d\x4b\x2c\xcd\x29\xa9\xad\x55\xd0\x48\x81\x30\x35\xab\xab\x53\xf3\x52\xe0\x7a\x9c\xf3\x73\x73\x33\x4b\xc0\xda\x20\xcc\x60\x0f\x47\x43\x34\x59\x05\x97\xc4\x92\x54\x24\x25\x21\x99\xb9\xa9\x7a\x3e\xf9\xc9\x89\x39\x70\x85\x6e\xa9\x25\xc9\x19\xa9\x29\x60\x45\x60\x36\x8a\x1a\x98\x95\x00\xfd\x49\x13\x8b\xcd\x00\x00\x00")
 
 func assetsReposMdBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,7 +171,7 @@ func assetsReposMd() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/repos.md", size: 179, mode: os.FileMode(420), modTime: time.Unix(1441249321, 0)}
+	info := bindataFileInfo{name: "assets/repos.md", size: 205, mode: os.FileMode(420), modTime: time.Unix(1792297081, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -174,7 +196,27 @@ func assetsStyleCss() (*asset, error) {
 	return a, nil
 }
 
-var _assetsWrapHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x4d\x8f\xdb\x36\x13\xbe\xfb\x57\x4c\xf6\x05\x42\xe9\x85\x2c\xed\x1e\x02\x14\xb5\xe8\x22\xe9\x06\x45\x81\x36\x01\x9a\xbd\x14\x8b\x3d\xcc\x92\x23\x99\x09\x45\x2a\x24\x65\xc7\xf0\xfa\xbf\x17\x94\xac\xc8\xf6\x7a\x53\x94\x07\x53\xe2\x7c\x3e\xcf\xcc\xd0\x2a\x5f\x49\x2b\xc2\xb6\x25\x58\x85\x46\x2f\x67\xe5\x8a\x50\x2e\x67\x00\x00\x65\x43\x01\x41\xac\xd0\x79\x0a\xfc\xaa\x0b\xd5\xfc\xa7\xab\x63\xd1\x2a\x84\x76\x4e\x5f\x3b\xb5\xe6\x57\xdf\xe6\x1d\xce\x85\x6d\x5a\x0c\xea\x51\xd3\x15\x08\x6b\x02\x99\xc0\xaf\x14\x71\x92\x35\x8d\x96\x41\x05\x4d\xcb\x5b\x0c\x08\x7f\x5a\x49\xda\xc3\x27\x72\x6b\x25\xa8\x2c\x06\xd1\x51\x00\x83\x0d\xf1\xab\xb5\xa2\x4d\x6b\x5d\x38\xf2\xb9\x51\x32\xac\xb8\xa4\x68\x37\xef\x5f\x32\x50\x46\x05\x85\x7a\xee\x05\x6a\xe2\x37\x63\x3c\x1f\xb6\x9a\x96\xbb\x5d\xfe\x29\x3e\xec\xf7\x65\x31\x9c\xcc\xca\x62\x80\x5a\x3e\x5a\xb9\x3d\x28\x4b\xb5\x06\x25\x39\x8b\x12\x72\x6c\x38\xed\x25\x08\x42\xa3\xf7\x9c\x3d\x3a\x34\x92\xc1\xca\x51\xc5\x59\xc1\x8e\x91\x94\x05\x2e\x67\x93\x49\xa7\x27\xfb\xfe\x40\xab\x65\x89\xa3\x65\xd3\x9b\xb0\xe5\x64\x5a\x16\x5a\xfd\xc8\xc2\x51\x6b\x3d\x5b\xfe\x15\xb7\xe7\xfa\x65\x31\xc6\x2b\x0b\xa9\xd6\x87\x44\x4a\xec\xf1\x78\xe1\xac\xd6\x73\x34\x62\x65\xdd\x98\xfc\xff\xd8\xf2\xce\xb6\x43\xd2\x13\xfc\x03\xcc\x06\xdd\x17\x69\x37\x66\x1e\xd9\x39\x22\x62\xb7\xcb\x7f\x1d\xaa\xb0\xdf\x3f\x8f\xe6\x85\x53\x6d\x98\xb4\x93\xaa\x33\x22\x28\x6b\x92\x14\x76\xb3\x13\x6c\x45\x01\xb7\xf4\x68\x3b\x23\x08\xe8\x1b\x89\x2e\x90\x07\x6b\xf4\x16\xc2\x8a\x40\xa3\x0f\x20\x50\x6b\xb0\x15\x20\x8c\x6e\x00\xab\x40\x2e\x6a\x9c\xfb\xf2\x2d\x09\x55\x29\x92\xb0\x41\x15\xa0\x25\xa7\xac\xcc\x4f\xb4\xbe\x3b\x91\x87\xb8\x7d\x76\x59\x6f\x90\x81\x6a\x1a\x92\x0a\x03\xc5\x4c\xe1\x6c\xad\xd1\x41\x50\x0d\xd9\x2e\x2c\x66\xcf\xa4\x8e\x42\xe7\x0c\x9c\x60\x3d\xd7\x19\xbd\xf4\x2d\xfc\x2d\x00\x87\xb0\x52\x3e\xbb\xa8\x17\x17\xba\xda\x03\x8f\x5b\xd7\x90\x09\xfe\x42\xd8\xd1\xa5\xc6\xc8\x09\xff\xf7\xf8\x71\x1d\x50\x00\x07\xd3\x69\xbd\x78\x51\x4f\x55\x90\xbc\x3a\xe2\x24\x3a\xcf\xb1\x6d\xf5\x36\x39\x40\xc8\xfa\x1c\xd3\xcb\x2e\xf6\x3f\xc8\x37\x96\xf5\x83\xdd\x00\x9f\x38\x87\xd7\xaf\xe1\xd5\xcb\x04\xc7\x25\x34\xa1\xbb\x1b\x54\x92\x83\xea\x0b\xc1\x27\x8c\x9e\xc2\x68\xd2\xb3\x34\x14\x3b\x7d\x21\x44\xc4\x7c\x48\xee\x3f\x01\xde\x9f\x1e\x9d\x63\x8f\x98\x87\x01\x7c\xdb\xcf\x1f\x70\x90\x56\xf4\x65\xcd\x6b\x0a\xef\x35\xc5\xc7\x77\xdb\xdf\x65\x72\x36\xa8\xe7\x89\x16\x05\x7c\xea\x15\x20\x58\x08\xb6\x3d\xed\xef\xe3\x18\x39\x4a\xf9\x7e\x4d\x26\xfc\xa1\x7c\x20\x43\x2e\x61\x42\x2b\xf1\x85\x65\x53\x9b\x50\x94\x5f\xea\x95\x5e\x90\xb7\xae\xdf\x6f\xa9\xc2\x4e\x87\xe4\x02\xee\x21\x60\x72\x9d\xc1\xf5\x99\x74\x9f\x41\x85\xda\xd3\x05\x00\x77\xb6\xae\x35\x1d\x6c\x61\x00\x7a\x8a\x63\xa3\x8c\xb4\x9b\x0b\x08\x06\x1b\x96\x9d\x0e\xf0\x8f\xb1\xf4\x93\x6b\x5b\xe0\x90\x1c\xfc\xb6\x58\xd3\xdf\x1f\xab\xca\x53\x80\xa7\xa7\xa9\x14\x83\xf7\x3b\xdb\xa6\x30\x87\xe4\xfb\xb1\xd0\x8a\x4c\xb8\xb3\x6d\x54\xbe\xbe\xd4\x3a\x27\xbc\xf7\x7f\x2e\xb9\x54\xbe\xd5\xb8\x8d\x53\x6e\x5b\x58\xc2\x9b\x6b\xf8\x05\xd8\xa3\xb6\xe2\x0b\x83\x9f\x81\x19\x6b\x88\x3d\xa3\xec\xe6\x4d\x7a\x81\xb7\x7d\x3a\x72\x5f\x16\xe3\xfd\xda\xbf\xee\x76\xaa\x82\xfc\x37\x6b\x6b\x4d\x6f\x0d\xea\x6d\x50\xc2\x8f\xb7\xf2\xe9\x4d\x3c\x11\xa5\x32\x9f\xd9\xac\xce\x5c\x86\x59\x93\xee\xd4\x3d\x3b\x73\xf0\xf1\xf1\x33\x89\xc0\x1e\xb8\x5b\xa8\x7b\xf7\xc0\xe3\xcf\xd3\xd3\x74\xb7\x9c\x52\x9c\x44\x71\xfe\x95\x0f\xdb\xd3\xd3\xfd\x43\x9a\xb7\x9d\x5f\x25\xdf\x2f\xae\x74\x9f\xf5\x42\xcd\x6f\xfe\x6f\x68\x03\xb7\x18\x28\x49\x17\xc8\x7d\x2e\x1c\x61\xa0\x43\xff\x27\x36\x9d\x6e\xc3\x86\xfb\xa3\xd1\xf0\xef\xb6\x77\x58\x7f\xc0\x86\x12\x9b\xde\x5f\x3f\x2c\x30\x47\xbf\x35\x82\xdf\x2c\x30\xf7\x4e\xf0\x7a\xd1\xe4\x2d\x3a\x32\xe1\x83\x95\x94\x2b\xe3\xc9\x85\x77\x54\x59\x47\x49\x84\x79\x56\xb2\x7d\x7a\xe8\x85\x6c\xac\x72\xc6\x06\xbe\x58\xc6\x8a\x62\xb3\xd9\xe4\x75\x4f\xca\x1c\x47\x56\x72\x61\x9b\x62\x7a\xfb\xec\x59\xc6\x6a\x3c\x19\xd0\x1a\x13\x36\x20\x62\x19\xb0\xdd\xee\x79\x65\xe2\x39\x76\xc1\xb2\xa3\x69\x89\x56\x9e\x8c\x8c\xb2\xd8\x99\xf1\x63\x87\x3d\xab\xf7\x50\x6e\x32\x72\xbf\x9f\x95\xc5\xf0\xc1\x52\x16\xc3\x17\xdb\x3f\x01\x00\x00\xff\xff\x6b\xad\x2f\x46\xc2\x09\x00\x00")
+var _assetsTableMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x51\xcb\x4e\xc3\x30\x10\xbc\xfb\x2b\x2c\xe5\x02\x95\xe2\x7c\x04\x08\x09\x51\x2a\xd4\x96\x03\xaa\x90\x6a\xea\x2d\x59\x29\x71\xda\xd8\x08\x50\xb2\xff\xce\xda\xa9\x4b\x9a\xfa\xb2\xa3\x7d\xcc\xce\x8e\xbb\xee\x1b\x7d\x29\xd5\x5a\x7f\x54\x40\x94\xc9\xae\x53\x44\x42\xcc\x36\x0c\x9e\x1b\x03\x15\xd1\xfb\x4d\x51\x07\xe4\x8a\x94\x53\xaf\xcb\xf9\x8b\xf6\x25\xd1\xad\x2c\xc2\xc8\x42\xd7\x3c\x3d\x13\x82\xf1\x3d\xb8\x5d\x8b\x07\x8f\x8d\x0d\x4c\x59\x26\x1f\x10\x2a\xe3\x84\x88\x51\xf6\x72\xfd\x7b\x00\x0e\x4b\x38\x7e\x61\x0b\x21\x33\x9a\x11\x79\x7c\xfd\x45\x98\xc0\x3c\xe7\x45\xad\xb6\x9f\x20\xd5\x40\xae\xe6\xe8\x3c\xd1\x26\xca\xbf\x10\x3c\x92\xda\xb3\x54\xdc\xf3\xb1\xbc\x9f\x68\xcb\xc5\x33\x02\x6b\x88\xce\x0d\x49\x19\xd1\x1b\x38\x2e\x56\x8e\xbb\x16\xcd\xb8\x6d\x72\xe6\xa9\x74\x0a\x62\xa0\x59\xed\x4a\xa8\x35\xbb\x9a\xc9\xbb\xc6\x3a\xdf\x6a\xb4\xde\x49\x6d\x8d\x7c\xb4\x06\x7e\x80\x3d\x79\x42\x1b\x0c\x08\x06\x46\x1f\xf6\x68\xf1\xdf\x86\x6b\x17\x46\x97\x27\x7a\x16\x13\x58\x92\xb0\xe1\x2f\x18\x6f\xa3\xca\x44\xc8\x67\x4e\x55\xfe\x01\xfb\x56\xfc\x4b\xfd\x01\x00\x00")
+
+func assetsTableMdBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTableMd,
+		"assets/table.md",
+	)
+}
+
+func assetsTableMd() (*asset, error) {
+	bytes, err := assetsTableMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/table.md", size: 509, mode: os.FileMode(420), modTime: time.Unix(1792296252, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assetsWrapHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x57\x5b\x6f\xdb\x36\x14\x7e\xcf\xaf\x60\x32\xa0\x92\x57\x59\x72\x1e\x0a\x6c\xb5\xe4\x2d\xbd\x60\x18\xd0\x36\xc0\x92\x97\x21\xc8\x03\x23\x1d\xcb\x6c\x29\x52\x25\x29\x3b\x9a\xe3\xff\xbe\x43\x5d\xa2\x8b\xe5\x14\x9b\x50\x54\x0e\xcf\xf5\x3b\x57\x2a\x3c\x4f\x64\x6c\xca\x1c\xc8\xc6\x64\x7c\x75\x16\x6e\x80\x26\xab\x33\x82\x4f\x98\x81\xa1\x24\xde\x50\xa5\xc1\x44\x17\x85\x59\xcf\x7f\xb9\xe8\x93\x36\xc6\xe4\x73\xf8\x5e\xb0\x6d\x74\xf1\x38\x2f\xe8\x3c\x96\x59\x4e\x0d\x7b\xe0\x70\x41\x62\x29\x0c\x08\x94\x63\x10\x41\x92\x42\x2b\x69\x98\xe1\xb0\xfa\x40\x51\xfe\xb3\x4c\x80\x6b\x72\x03\x6a\xcb\x62\x08\x83\x9a\xd4\x33\x20\x68\x06\xd1\xc5\x96\xc1\x2e\x97\xca\xf4\x74\xee\x58\x62\x36\x51\x02\x56\x6e\x5e\xfd\xe1\x11\x26\x98\x61\x94\xcf\x75\x4c\x39\x44\x97\xad\x3d\x6d\x4a\x54\xba\xdf\xfb\x37\xf6\xc7\xe1\x10\x06\xf5\xc9\x59\x18\xd4\x50\xc3\x07\x99\x94\x0d\x73\xc2\xb6\x84\x25\x91\x63\x29\xa0\x9c\xfa\xb4\xa2\x60\x20\x38\xd5\x3a\x72\x1e\x14\x15\x89\x43\x36\x0a\xd6\x91\x13\x38\x7d\x24\x61\x40\x57\x67\x9d\x48\xc1\x3b\xf9\xea\x80\xb3\x15\xea\x69\x24\xb3\x4a\xc4\x59\x75\xa2\x61\x80\x0c\x2f\x48\x28\xc8\x25\x0a\xfc\x65\x5f\xc7\xfc\x61\xd0\xda\x0b\x03\x84\xd1\x38\x82\xd2\x16\x8f\x8e\x95\xe4\x7c\x4e\x45\xbc\x91\xaa\x75\xfe\x27\x67\x75\x2b\xf3\xda\xe9\x0e\x7e\x03\x33\xa3\xea\x5b\x22\x77\x62\x6e\xa3\xd3\x0b\x04\x06\xf2\x7d\x9d\x85\xc3\xe1\xd8\x1a\xda\x61\xb9\xe9\xb8\xdd\x75\x21\x62\xc3\xa4\x70\x67\x64\x7f\x36\xc0\x16\x04\xe4\x03\x3c\x48\xa4\x03\x81\x47\x88\x0b\x03\x9a\x48\xc1\x4b\x62\x36\x40\xd0\x09\x43\x30\x91\x9c\xc8\x35\xa1\xa4\x55\x43\xe8\xda\x80\xb2\x1c\x63\x5d\x3a\x87\x98\xad\x19\x24\x64\x47\x99\x21\x39\x28\x26\x13\x7f\xc0\xf5\xac\x24\x69\xec\x56\xde\x79\x95\x00\x96\x4f\x96\x41\xc2\xa8\x01\xeb\x29\x19\x3d\x5b\x8a\x46\x59\x06\xb2\x30\xcb\xb3\x23\xaa\x02\x53\x28\x41\x06\x58\xc9\xc4\x63\xb5\x54\x25\xfc\x68\x48\x84\x20\x98\xf6\x26\xf9\xec\x43\x55\xaa\x91\x09\x5f\x45\x86\xc1\xd6\x13\x66\x5b\x95\x9c\xda\x98\x44\x3f\xb6\x6f\x9f\x06\x05\xb2\x8b\x82\xf3\xe5\x49\x3e\xb6\x26\xee\x79\x2f\x26\x56\xb9\x4f\xf3\x9c\x97\x6e\x03\xc1\xab\x7c\x9c\x4d\xab\x38\xbc\xe0\xaf\x4d\xeb\x17\xb9\x43\x17\x9e\xf5\x93\x57\xaf\xc8\xf9\xe9\x00\xdb\x27\xe6\x40\xd5\x6d\xcd\xe2\x36\xac\x27\x8c\x77\x18\x71\x70\xb5\x22\x55\x94\xea\x64\xcf\x4e\x98\xb0\x98\x1b\xe7\xfe\x13\xe0\xc3\xf0\x68\x8c\xdd\x62\xae\x1b\xf0\xaa\xea\x3f\xf4\x0b\x67\x6e\x95\x56\x3f\x05\xf3\x91\x83\xfd\xf9\xae\xfc\x33\x71\x47\x8d\x3a\x76\x14\xeb\xfc\xa6\x62\x20\x46\xe2\xbf\x7c\x58\xdf\x7d\x1b\x3e\x4d\x92\x8f\x5b\x54\xfb\x89\x69\x6c\x56\x50\xae\x13\x73\x16\x7f\x73\xbc\xae\x4c\xc0\xd2\xa7\x6a\xa5\x22\xf8\xb9\xaa\xde\x1f\x60\x4d\x0b\x6e\xdc\x09\xdc\xb5\x41\x77\xe1\x91\xc5\x88\x7a\x40\x33\x94\x6b\x98\x00\x70\x2b\xd3\x94\x43\x23\x4b\x6a\xa0\x43\x1c\x3b\x26\x70\xf2\x4c\x20\xa8\x65\x10\xc2\xa0\x81\x5f\xc6\x52\x75\xae\xcc\x31\xe6\x6e\xa3\x37\xa7\x29\xfc\x7d\xbd\x5e\x63\x6d\x90\xa7\xa7\x2e\x15\xb5\x76\x9c\x88\x33\x32\x27\xee\xf3\x31\x86\x0d\x5f\x78\x6c\x99\x17\x53\xa5\x33\x88\x7b\xb5\x5c\xfc\x84\xe9\x9c\xd3\xd2\x76\x39\x0a\xae\xc8\x9b\x05\xf9\x8d\x38\x0f\x5c\x62\x06\xc8\x5b\xe2\x08\x29\xc0\x39\x0a\xd9\xe5\x9b\xd9\x44\xdc\x0e\xb3\x36\xf6\xb8\xba\x9a\xf9\xfa\xbf\xa6\xad\xa2\x3b\xf2\x19\x54\x46\x59\x82\x63\x28\x01\x52\xf9\xa3\x09\xd5\x04\x9b\x30\x55\x34\xd3\x3e\xb9\xb5\xc3\x97\xe1\x92\x53\x25\x61\xf5\x40\x1e\x2b\xe2\x12\x97\x63\x82\x24\x62\x43\xa9\x31\x5f\x66\xd3\x69\x38\x2a\xfd\xc6\x4a\xaf\xe8\xbf\x17\xa0\xca\x1b\xe0\x10\x1b\xa9\xae\xb0\x82\x1c\xac\x35\x8c\x92\x75\xca\xe7\x54\xa4\x05\xea\x9d\x67\xb5\xab\x47\x4d\x60\xbb\xb4\xd6\xe9\x73\x10\x29\xda\x8e\xa2\x08\x33\xd3\x8c\xe1\xe9\xe6\xc3\x38\xf5\x3d\x88\x15\xe0\x38\x68\x3a\xaf\x2a\x2c\x64\x98\x6a\x37\x1b\x8e\x2d\x28\x6d\xd7\x06\x86\x23\x67\x42\x20\x74\x2d\xab\x25\xd5\xe8\x8d\xa9\x10\xd2\xd8\x8b\x92\x48\x81\x14\x22\x99\xde\x50\x36\x58\x47\x0d\x8b\xf2\xbe\x56\x31\xfa\xe6\xd8\xdb\x94\x7e\x1b\x04\x71\x22\xfc\xaf\x1a\x2f\x05\x6c\xab\x7c\x01\x26\x10\x79\x16\x34\xc1\xf8\xfd\x72\xe1\xff\xea\x5f\xe2\xca\xd5\xa6\x3d\xf3\x33\x66\x05\x46\xe5\xd4\xe8\xc6\xd2\xd4\xfa\x5a\xb1\x94\x09\x6b\x83\x62\xe1\x95\x99\x2c\x2c\xf7\x14\x3b\xa6\x1b\x93\xfb\xa3\x5d\xb2\xc6\x19\xe6\xda\xc0\x32\xe4\x5c\x2c\xf1\x15\x92\x41\x46\xf0\xe8\xf5\xeb\x97\xb6\xa0\xcd\x77\xd4\xc8\xdc\xb1\x7b\xec\x49\x85\x89\xf8\x82\xf9\x3f\xbd\x11\xed\xe5\xe4\x74\x0a\x91\xea\x9c\x9a\xeb\x48\xf3\xab\x6b\xcd\x17\xbc\x4f\xda\x30\xb4\xa5\xb5\x3c\xc9\x6e\xe7\x7d\x73\xcf\x19\x38\xda\x3b\x3f\x61\x0c\xa1\xf5\xe0\xf8\x78\x69\xe3\x34\x86\xf7\x1b\xc6\x13\x17\x35\x7b\x96\x61\x6a\x87\x1c\x6b\x6b\xf3\xdb\x5c\x6c\xd9\x3f\xe0\xee\xb5\xa1\xca\x5c\x8b\x4f\x98\xa5\xb7\xf5\xa8\x38\x4c\x28\x6b\x25\x55\x21\xc6\x73\x7b\xbc\x9c\x9e\xe3\x69\xef\x79\x76\xdf\x81\x48\x6a\x67\xeb\x92\xe8\x89\x9f\x1c\x44\xfb\x3d\xf6\xa4\xff\x87\x94\x38\xd8\xaf\x04\xe5\xa5\x61\xb1\x6e\xaf\x87\xc3\x21\xd5\x8d\x28\xe6\x69\x4f\x7a\xa9\xa7\x3c\xea\x65\xb3\x3d\xbb\x73\x46\x0a\xae\x1f\xbe\xe2\x80\x70\xee\x23\xb5\x64\x77\xea\x3e\xb2\xff\x3d\x3d\x75\x85\x39\xac\x2e\xd7\x92\xfd\xef\x51\xfd\x7a\x7a\xba\xbb\x9f\xf9\x79\xa1\x37\xee\xf3\x0d\x6a\x76\xf0\x2a\x22\x8f\x2e\x7f\x16\xb0\x23\x78\x71\x07\x04\x44\x23\x3d\xaa\x25\x39\xeb\x8a\x30\x43\x6a\xb7\xa3\xf5\xbb\xf2\x96\xa6\xb6\x8a\x90\xe9\x6e\x71\xbf\xa4\x3e\xd5\xa5\x88\xa3\x4b\xfc\x85\x6d\x1c\xa5\xcb\xac\x9f\x7c\x26\x34\x28\xf3\x0e\xb0\x61\xc0\xb5\x30\x47\x99\xc2\x88\xd6\x4b\xc9\x6b\xd3\xe0\xb5\xb3\xc8\x73\x82\x60\xb7\xdb\xf9\x69\x15\x14\xbc\x10\x34\x51\xf1\xf1\x1b\x2b\xe8\xfe\xc2\xbe\xf7\x9c\x94\x0e\x4a\x3f\xa5\xb8\xec\x2b\x44\xb8\x2a\x1d\xbc\xb0\x1f\x65\xc6\x9e\xd3\xc2\x48\xa7\x97\x5d\x2b\xa5\x31\xf9\x96\x66\x47\x95\xfd\xea\x72\x8e\xf2\x5d\xa7\x1b\xd9\x30\xbd\x61\x50\x7f\x39\xe1\x87\x54\xf5\xe9\xf8\x2f\x97\x43\x05\x28\x4b\x0e\x00\x00")
 
 func assetsWrapHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -189,7 +231,7 @@ func assetsWrapHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/wrap.html", size: 2498, mode: os.FileMode(420), modTime: time.Unix(1449768986, 0)}
+	info := bindataFileInfo{name: "assets/wrap.html", size: 3659, mode: os.FileMode(420), modTime: time.Unix(1792300189, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -246,11 +288,13 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"assets/field.md": assetsFieldMd,
 	"assets/full.md": assetsFullMd,
 	"assets/index.md": assetsIndexMd,
 	"assets/models.md": assetsModelsMd,
 	"assets/repos.md": assetsReposMd,
 	"assets/style.css": assetsStyleCss,
+	"assets/table.md": assetsTableMd,
 	"assets/wrap.html": assetsWrapHtml,
 }
 
@@ -295,6 +339,8 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"assets": &bintree{nil, map[string]*bintree{
+		"field.md": &bintree{assetsFieldMd, map[string]*bintree{
+		}},
 		"full.md": &bintree{assetsFullMd, map[string]*bintree{
 		}},
 		"index.md": &bintree{assetsIndexMd, map[string]*bintree{
@@ -305,6 +351,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		}},
 		"style.css": &bintree{assetsStyleCss, map[string]*bintree{
 		}},
+		"table.md": &bintree{assetsTableMd, map[string]*bintree{
+		}},
 		"wrap.html": &bintree{assetsWrapHtml, map[string]*bintree{
 		}},
 	}},
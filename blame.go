@@ -0,0 +1,438 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Attributes of fields whose changes are tracked. The values are those of
+// the query attributes.
+var historyFieldAttrs = []string{
+	"label",
+	"description",
+	"type",
+	"length",
+	"precision",
+	"scale",
+	"default",
+	"required",
+	"not_null",
+	"primary_key",
+	"references",
+}
+
+// Attributes of tables whose changes are tracked.
+var historyTableAttrs = []struct {
+	name string
+	get  func(t *dms.Table) string
+}{
+	{"label", func(t *dms.Table) string { return t.Label }},
+	{"description", func(t *dms.Table) string { return t.Description }},
+}
+
+// AttrChange is a change of an attribute of a table or field. An attribute
+// changed from an empty value was set when the table or field was added.
+type AttrChange struct {
+	Attr   string      `json:"attribute"`
+	From   string      `json:"from"`
+	To     string      `json:"to"`
+	Commit *CommitInfo `json:"commit"`
+}
+
+// AttrBlame is the current value of an attribute and the commit it was last
+// changed in.
+type AttrBlame struct {
+	Attr   string      `json:"attribute"`
+	Value  string      `json:"value"`
+	Commit *CommitInfo `json:"commit"`
+}
+
+// AttrHistory is the history of the attributes of a table or field.
+type AttrHistory struct {
+	Model   string `json:"model"`
+	Version string `json:"version"`
+	Table   string `json:"table"`
+	Field   string `json:"field,omitempty"`
+
+	// Changes from the newest to the oldest.
+	Changes []*AttrChange `json:"changes"`
+	Blame   []*AttrBlame  `json:"blame"`
+
+	model *dms.Model
+}
+
+// modelHistory holds the attribute changes of the tables and fields of a
+// model in commit order, keyed by table and table.field.
+type modelHistory struct {
+	tables map[string][]*AttrChange
+	fields map[string][]*AttrChange
+
+	// Commit of the repo the history was built at and the last commit
+	// that changed the model directory.
+	sha  string
+	last string
+
+	// Attributes as of the last commit the history is extended from.
+	prevTables map[string]map[string]string
+	prevFields map[string]map[string]string
+}
+
+// modelHistoryEntry holds the history of a model. The lock is held while the
+// history is built.
+type modelHistoryEntry struct {
+	sync.Mutex
+	h *modelHistory
+}
+
+// historyIndex caches the history of a model by the path of the model. The
+// history is replaced when the repo moves to another commit.
+var historyIndex = struct {
+	sync.Mutex
+	m map[string]*modelHistoryEntry
+}{
+	m: make(map[string]*modelHistoryEntry),
+}
+
+// modelAtCommit parses the model from the files of its directory at the
+// commit. Nil is returned if the model did not exist at the commit.
+func (r *Repo) modelAtCommit(m *dms.Model, rel, sha string) (*dms.Model, error) {
+	dir, err := ioutil.TempDir("", "dms-history")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	// Nothing is written in the commit that removed the directory.
+	if err := r.client.Extract(r.path, sha, dir, rel); err != nil {
+		return nil, err
+	}
+
+	return loadModels(dir).Get(m.Name, m.Version), nil
+}
+
+// recordChanges appends the changes between the previous and current values
+// of the attributes. Nil values mean the table or field did not exist.
+func recordChanges(changes []*AttrChange, attrs []string, prev, cur map[string]string, c *CommitInfo) []*AttrChange {
+	if cur == nil {
+		return changes
+	}
+
+	for _, a := range attrs {
+		if prev[a] != cur[a] {
+			changes = append(changes, &AttrChange{
+				Attr:   a,
+				From:   prev[a],
+				To:     cur[a],
+				Commit: c,
+			})
+		}
+	}
+
+	return changes
+}
+
+// extendHistory returns a copy of the history to append changes to. The
+// slices are capped so appends do not write into the shared arrays.
+func extendHistory(prev *modelHistory) *modelHistory {
+	h := &modelHistory{
+		tables:     make(map[string][]*AttrChange, len(prev.tables)),
+		fields:     make(map[string][]*AttrChange, len(prev.fields)),
+		last:       prev.last,
+		prevTables: prev.prevTables,
+		prevFields: prev.prevFields,
+	}
+
+	for k, l := range prev.tables {
+		h.tables[k] = l[:len(l):len(l)]
+	}
+
+	for k, l := range prev.fields {
+		h.fields[k] = l[:len(l):len(l)]
+	}
+
+	return h
+}
+
+// buildModelHistory parses the model at each commit that changed its
+// directory and attributes the changes of each attribute to the commit. If
+// a previous history is given only the commits after its last one are
+// parsed, unless the history of the repo was rewritten.
+func buildModelHistory(r *Repo, m *dms.Model, prev *modelHistory) (*modelHistory, error) {
+	rel, err := filepath.Rel(r.path, m.Path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := r.client.Log(r.path, rel)
+
+	if err != nil {
+		return nil, err
+	}
+
+	h := &modelHistory{
+		tables: make(map[string][]*AttrChange),
+		fields: make(map[string][]*AttrChange),
+	}
+
+	if prev != nil {
+		for i, c := range commits {
+			if c.SHA1 == prev.last {
+				h = extendHistory(prev)
+				commits = commits[i+1:]
+				break
+			}
+		}
+	}
+
+	tableAttrs := make([]string, len(historyTableAttrs))
+
+	for i, a := range historyTableAttrs {
+		tableAttrs[i] = a.name
+	}
+
+	prevTables, prevFields := h.prevTables, h.prevFields
+
+	for _, c := range commits {
+		cm, err := r.modelAtCommit(m, rel, c.SHA1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tables := make(map[string]map[string]string)
+		fields := make(map[string]map[string]string)
+
+		if cm != nil {
+			schema := indexSchema(cm.Schema)
+
+			for _, t := range cm.Tables.List() {
+				tk := strings.ToLower(t.Name)
+				ts := schemaForTable(schema, t.Name)
+
+				tables[tk] = make(map[string]string)
+
+				for _, a := range historyTableAttrs {
+					tables[tk][a.name] = a.get(t)
+				}
+
+				for _, f := range t.Fields.List() {
+					fk := tk + "." + strings.ToLower(f.Name)
+
+					fields[fk] = make(map[string]string)
+
+					for _, a := range historyFieldAttrs {
+						fields[fk][a] = queryAttrs[a].get(f, ts)
+					}
+				}
+			}
+		}
+
+		for k, cur := range tables {
+			h.tables[k] = recordChanges(h.tables[k], tableAttrs, prevTables[k], cur, c)
+		}
+
+		for k, cur := range fields {
+			h.fields[k] = recordChanges(h.fields[k], historyFieldAttrs, prevFields[k], cur, c)
+		}
+
+		prevTables, prevFields = tables, fields
+		h.last = c.SHA1
+	}
+
+	h.prevTables, h.prevFields = prevTables, prevFields
+
+	return h, nil
+}
+
+// repoOf returns the repo the model was read from.
+func repoOf(m *dms.Model) *Repo {
+	for _, r := range registeredRepos {
+		if r.git && strings.HasPrefix(m.Path, r.path+string(filepath.Separator)) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// getModelHistory returns the history of the model, building it on first
+// use. Nil is returned if the model was not read from a git repo.
+func getModelHistory(m *dms.Model) (*modelHistory, error) {
+	r := repoOf(m)
+
+	if r == nil {
+		return nil, nil
+	}
+
+	r.Lock()
+	sha := r.CommitSHA1
+	r.Unlock()
+
+	historyIndex.Lock()
+
+	e, ok := historyIndex.m[m.Path]
+
+	if !ok {
+		e = &modelHistoryEntry{}
+		historyIndex.m[m.Path] = e
+	}
+
+	historyIndex.Unlock()
+
+	e.Lock()
+	defer e.Unlock()
+
+	if e.h != nil && e.h.sha == sha {
+		return e.h, nil
+	}
+
+	h, err := buildModelHistory(r, m, e.h)
+
+	if err != nil {
+		return nil, err
+	}
+
+	h.sha = sha
+	e.h = h
+
+	return h, nil
+}
+
+// newAttrHistory orders the changes from the newest to the oldest and blames
+// each attribute on its last change.
+func newAttrHistory(m *dms.Model, table, field string, attrs []string, changes []*AttrChange) *AttrHistory {
+	h := &AttrHistory{
+		Model:   m.Name,
+		Version: m.QualifiedVersion(),
+		Table:   table,
+		Field:   field,
+		Changes: make([]*AttrChange, 0, len(changes)),
+		Blame:   make([]*AttrBlame, 0),
+		model:   m,
+	}
+
+	// The commits are reversed, the changes of a commit stay in the order
+	// of the attributes.
+	for i := len(changes); i > 0; {
+		j := i - 1
+
+		for j > 0 && changes[j-1].Commit == changes[i-1].Commit {
+			j--
+		}
+
+		h.Changes = append(h.Changes, changes[j:i]...)
+		i = j
+	}
+
+	last := make(map[string]*AttrChange)
+
+	for _, c := range changes {
+		last[c.Attr] = c
+	}
+
+	for _, a := range attrs {
+		if c, ok := last[a]; ok {
+			h.Blame = append(h.Blame, &AttrBlame{
+				Attr:   a,
+				Value:  c.To,
+				Commit: c.Commit,
+			})
+		}
+	}
+
+	return h
+}
+
+// FieldHistory returns the history of the attributes of the field or nil if
+// the model was not read from a git repo.
+func FieldHistory(f *dms.Field) (*AttrHistory, error) {
+	m := f.Table.Model
+
+	h, err := getModelHistory(m)
+
+	if h == nil || err != nil {
+		return nil, err
+	}
+
+	k := strings.ToLower(f.Table.Name + "." + f.Name)
+
+	return newAttrHistory(m, f.Table.Name, f.Name, historyFieldAttrs, h.fields[k]), nil
+}
+
+// TableHistory returns the history of the attributes of the table or nil if
+// the model was not read from a git repo.
+func TableHistory(t *dms.Table) (*AttrHistory, error) {
+	m := t.Model
+
+	h, err := getModelHistory(m)
+
+	if h == nil || err != nil {
+		return nil, err
+	}
+
+	attrs := make([]string, len(historyTableAttrs))
+
+	for i, a := range historyTableAttrs {
+		attrs[i] = a.name
+	}
+
+	return newAttrHistory(m, t.Name, "", attrs, h.tables[strings.ToLower(t.Name)]), nil
+}
+
+// markdownCell escapes a value for a cell of a Markdown table.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ", "\r", "").Replace(s)
+}
+
+func shortSHA1(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+
+	return sha
+}
+
+// WriteMarkdown writes the blame of each attribute followed by the changes.
+func (h *AttrHistory) WriteMarkdown(w io.Writer) {
+	m := h.model
+	path := fmt.Sprintf("/models/%s/%s", m.URLPath(), h.Table)
+
+	if h.Field != "" {
+		fmt.Fprintf(w, "# %s.%s history\n\n", h.Table, h.Field)
+		fmt.Fprintf(w, "*[%s](/models/%s) / [%s](%s) / [%s](%s/%s)*\n", m, m.URLPath(), h.Table, path, h.Field, path, h.Field)
+	} else {
+		fmt.Fprintf(w, "# %s history\n\n", h.Table)
+		fmt.Fprintf(w, "*[%s](/models/%s) / [%s](%s)*\n", m, m.URLPath(), h.Table, path)
+	}
+
+	if len(h.Changes) == 0 {
+		fmt.Fprint(w, "\nNo changes were found in the history of the repository.\n")
+		return
+	}
+
+	fmt.Fprint(w, "\n## Blame\n\n")
+	fmt.Fprint(w, "Attribute | Value | Commit | Author | Date\n")
+	fmt.Fprint(w, "--------- | ----- | ------ | ------ | ----\n")
+
+	for _, b := range h.Blame {
+		fmt.Fprintf(w, "%s | %s | `%s` | %s | %s\n", b.Attr, markdownCell(b.Value), shortSHA1(b.Commit.SHA1), markdownCell(b.Commit.Author), b.Commit.Time.UTC().Format("2006-01-02"))
+	}
+
+	fmt.Fprint(w, "\n## Changes\n\n")
+	fmt.Fprint(w, "Date | Commit | Author | Attribute | From | To | Message\n")
+	fmt.Fprint(w, "---- | ------ | ------ | --------- | ---- | -- | -------\n")
+
+	for _, c := range h.Changes {
+		fmt.Fprintf(w, "%s | `%s` | %s | %s | %s | %s | %s\n", c.Commit.Time.UTC().Format("2006-01-02"), shortSHA1(c.Commit.SHA1), markdownCell(c.Commit.Author), c.Attr, markdownCell(c.From), markdownCell(c.To), markdownCell(c.Commit.Message))
+	}
+}
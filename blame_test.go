@@ -0,0 +1,205 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// testCommitter commits files to a repository with increasing times.
+type testCommitter struct {
+	t    *testing.T
+	dir  string
+	repo *git.Repository
+	when time.Time
+}
+
+func newTestCommitter(t *testing.T) *testCommitter {
+	dir := t.TempDir()
+
+	repo, err := git.PlainInit(dir, false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCommitter{
+		t:    t,
+		dir:  dir,
+		repo: repo,
+		when: time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// commit writes the files and commits them as the author. The SHA1 of the
+// commit is returned.
+func (c *testCommitter) commit(author, msg string, files map[string]string) string {
+	w, err := c.repo.Worktree()
+
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	for n, s := range files {
+		path := filepath.Join(c.dir, filepath.FromSlash(n))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			c.t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(path, []byte(s), 0644); err != nil {
+			c.t.Fatal(err)
+		}
+
+		if _, err := w.Add(n); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+
+	c.when = c.when.Add(24 * time.Hour)
+
+	sig := &object.Signature{
+		Name:  author,
+		Email: author + "@example.com",
+		When:  c.when,
+	}
+
+	h, err := w.Commit(msg, &git.CommitOptions{Author: sig, Committer: sig})
+
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	return h.String()
+}
+
+// testModelRevision returns the definitions files of the test model 1.0.0
+// with a single person_id field by their path in the repository.
+func testModelRevision(desc, typ string) map[string]string {
+	files := make(map[string]string)
+
+	for n, s := range testModelFiles("1.0.0", "person_id,"+desc+",yes\n", "person_id,"+typ+",,,,\n") {
+		files["test/1.0.0/"+n] = s
+	}
+
+	return files
+}
+
+// blameCommits returns the SHA1 of the commit each attribute is blamed on.
+func blameCommits(h *AttrHistory) map[string]string {
+	commits := make(map[string]string)
+
+	for _, b := range h.Blame {
+		commits[b.Attr] = b.Commit.SHA1
+	}
+
+	return commits
+}
+
+func TestModelHistory(t *testing.T) {
+	c := newTestCommitter(t)
+
+	first := c.commit("jane", "Add the test model", testModelRevision("Person id", "integer"))
+	second := c.commit("bob", "Describe person_id", testModelRevision("Unique person id", "integer"))
+
+	// Changes outside the model directory are not part of its history.
+	c.commit("bob", "Add notes", map[string]string{"notes.txt": "notes"})
+
+	r := &Repo{
+		URL:    c.dir,
+		Ref:    "master",
+		path:   c.dir,
+		git:    true,
+		client: goGitClient{},
+	}
+
+	m := loadModels(c.dir).Get("test", "1.0.0")
+
+	if m == nil {
+		t.Fatal("model test 1.0.0 not parsed")
+	}
+
+	h, err := buildModelHistory(r, m, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fh := newAttrHistory(m, "person", "person_id", historyFieldAttrs, h.fields["person.person_id"])
+
+	if len(fh.Changes) == 0 || fh.Changes[0].Attr != "description" || fh.Changes[0].Commit.SHA1 != second {
+		t.Fatalf("expected the description change of %s first, got %+v", second, fh.Changes)
+	}
+
+	if fh.Changes[0].From != "Person id" || fh.Changes[0].To != "Unique person id" {
+		t.Errorf("expected description from %q to %q, got %q to %q", "Person id", "Unique person id", fh.Changes[0].From, fh.Changes[0].To)
+	}
+
+	if fh.Changes[0].Commit.Author != "bob" {
+		t.Errorf("expected author bob, got %s", fh.Changes[0].Commit.Author)
+	}
+
+	blame := blameCommits(fh)
+
+	if blame["description"] != second {
+		t.Errorf("expected description blamed on %s, got %s", second, blame["description"])
+	}
+
+	if blame["type"] != first {
+		t.Errorf("expected type blamed on %s, got %s", first, blame["type"])
+	}
+
+	// The history is extended with the later commits.
+	third := c.commit("jane", "Widen person_id", testModelRevision("Unique person id", "bigint"))
+
+	n := len(h.fields["person.person_id"])
+
+	eh, err := buildModelHistory(r, m, h)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if eh.last != third {
+		t.Errorf("expected the history to end at %s, got %s", third, eh.last)
+	}
+
+	if len(h.fields["person.person_id"]) != n {
+		t.Errorf("expected the previous history to keep %d changes, got %d", n, len(h.fields["person.person_id"]))
+	}
+
+	fh = newAttrHistory(m, "person", "person_id", historyFieldAttrs, eh.fields["person.person_id"])
+	blame = blameCommits(fh)
+
+	if blame["type"] != third {
+		t.Errorf("expected type blamed on %s, got %s", third, blame["type"])
+	}
+
+	if blame["description"] != second {
+		t.Errorf("expected description blamed on %s, got %s", second, blame["description"])
+	}
+
+	// The extended history matches a history built from scratch.
+	fresh, err := buildModelHistory(r, m, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, b := eh.fields["person.person_id"], fresh.fields["person.person_id"]
+
+	if len(a) != len(b) {
+		t.Fatalf("expected %d changes, got %d", len(b), len(a))
+	}
+
+	for i := range a {
+		if a[i].Attr != b[i].Attr || a[i].To != b[i].To || a[i].Commit.SHA1 != b[i].Commit.SHA1 {
+			t.Errorf("change %d: expected %s %q in %s, got %s %q in %s", i, b[i].Attr, b[i].To, b[i].Commit.SHA1, a[i].Attr, a[i].To, a[i].Commit.SHA1)
+		}
+	}
+}
@@ -37,6 +37,9 @@ type Client struct {
 	URL     string
 	Timeout time.Duration
 
+	// Links to include in the representations of models.
+	Expand Expand
+
 	url  *url.URL
 	http *http.Client
 }
@@ -56,6 +59,10 @@ func (c *Client) resource(name string, params map[string]interface{}) *url.URL {
 	url := *c.url
 	url.Path = path
 
+	if !c.Expand.Empty() {
+		url.RawQuery = "expand=" + c.Expand.String()
+	}
+
 	return &url
 }
 
@@ -1,6 +1,9 @@
 package client
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestClient(t *testing.T) {
 	c, err := New(DefaultServiceURL)
@@ -31,3 +34,65 @@ func TestClient(t *testing.T) {
 		t.Logf("Error fetching schema: %s", err)
 	}
 }
+
+func TestRelink(t *testing.T) {
+	b := []byte(`[{
+		"name": "a",
+		"version": "1",
+		"tables": [{
+			"name": "person",
+			"fields": [{
+				"name": "id",
+				"links": {
+					"inbound_refs": [{"name": "fk", "model": "a", "version": "1", "table": "visit", "field": "person_id"}],
+					"mappings": [{"comment": "direct", "model": "b", "version": "1", "table": "patient", "field": "id"}]
+				}
+			}]
+		}, {
+			"name": "visit",
+			"fields": [{
+				"name": "person_id",
+				"links": {
+					"references": {"name": "fk", "model": "a", "version": "1", "table": "person", "field": "id"}
+				}
+			}]
+		}]
+	}, {
+		"name": "b",
+		"version": "1",
+		"tables": [{
+			"name": "patient",
+			"fields": [{"name": "id"}]
+		}]
+	}]`)
+
+	var models Models
+
+	if err := json.Unmarshal(b, &models); err != nil {
+		t.Fatalf("Error decoding models: %s", err)
+	}
+
+	id := models.Field(FieldID{"a", "1", "person", "id"})
+	ref := models.Field(FieldID{"a", "1", "visit", "person_id"})
+	mapped := models.Field(FieldID{"b", "1", "patient", "id"})
+
+	if id == nil || ref == nil || mapped == nil {
+		t.Fatal("Expected fields to be decoded")
+	}
+
+	if id.Table.Model.Name != "a" {
+		t.Errorf("Expected field to be linked to its table and model")
+	}
+
+	if ref.References == nil || ref.References.Field != id {
+		t.Errorf("Expected reference to be relinked")
+	}
+
+	if len(id.InboundRefs) != 1 || id.InboundRefs[0].Field != ref {
+		t.Errorf("Expected inbound reference to be relinked")
+	}
+
+	if len(id.Mappings) != 1 || id.Mappings[0].Field != mapped || id.Mappings[0].Comment != "direct" {
+		t.Errorf("Expected mapping to be relinked")
+	}
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Names of the links that can be expanded in the JSON representation.
+const (
+	ExpandReferences = "references"
+	ExpandMappings   = "mappings"
+	ExpandInbound    = "inbound"
+)
+
+// Expand is the set of links included in the expanded JSON representation
+// of models, tables and fields.
+type Expand struct {
+	References bool
+	Mappings   bool
+	Inbound    bool
+}
+
+// ParseExpand parses a comma-separated list of links.
+func ParseExpand(s string) (Expand, error) {
+	var e Expand
+
+	for _, n := range strings.Split(s, ",") {
+		switch strings.ToLower(strings.TrimSpace(n)) {
+		case "":
+		case ExpandReferences:
+			e.References = true
+		case ExpandMappings:
+			e.Mappings = true
+		case ExpandInbound:
+			e.Inbound = true
+		default:
+			return e, fmt.Errorf("unknown link %q; supported links: %s, %s, %s", n, ExpandReferences, ExpandMappings, ExpandInbound)
+		}
+	}
+
+	return e, nil
+}
+
+// Empty returns true if no links are expanded.
+func (e Expand) Empty() bool {
+	return !e.References && !e.Mappings && !e.Inbound
+}
+
+func (e Expand) String() string {
+	var l []string
+
+	if e.References {
+		l = append(l, ExpandReferences)
+	}
+
+	if e.Mappings {
+		l = append(l, ExpandMappings)
+	}
+
+	if e.Inbound {
+		l = append(l, ExpandInbound)
+	}
+
+	return strings.Join(l, ",")
+}
+
+// FieldID identifies a field across models. It takes the place of pointers
+// in the expanded representation which would otherwise contain cycles.
+type FieldID struct {
+	Model string `json:"model"`
+
+	// Version of the model, qualified with the ref if the model is not of
+	// the default ref of its repository.
+	Version string `json:"version"`
+
+	Table string `json:"table"`
+	Field string `json:"field"`
+}
+
+func (id FieldID) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", id.Model, id.Version, id.Table, id.Field)
+}
+
+// ID returns the identifier of the field.
+func (f *Field) ID() FieldID {
+	return FieldID{
+		Model:   f.Table.Model.Name,
+		Version: f.Table.Model.QualifiedVersion(),
+		Table:   f.Table.Name,
+		Field:   f.Name,
+	}
+}
+
+// ReferenceLink is a reference to or from the identified field.
+type ReferenceLink struct {
+	Name string `json:"name"`
+	FieldID
+}
+
+// MappingLink is a mapping to the identified field.
+type MappingLink struct {
+	Comment  string   `json:"comment"`
+	Warnings []string `json:"warnings,omitempty"`
+	FieldID
+}
+
+// FieldLinks are the links of a field in the expanded representation.
+type FieldLinks struct {
+	References  *ReferenceLink   `json:"references,omitempty"`
+	InboundRefs []*ReferenceLink `json:"inbound_refs,omitempty"`
+	Mappings    []*MappingLink   `json:"mappings,omitempty"`
+}
+
+// Links returns the expanded links of the field or nil if there are none.
+func (e Expand) Links(f *Field) *FieldLinks {
+	var l FieldLinks
+
+	if e.References && f.References != nil {
+		l.References = &ReferenceLink{
+			Name:    f.References.Name,
+			FieldID: f.References.Field.ID(),
+		}
+	}
+
+	if e.Inbound {
+		for _, r := range f.InboundRefs {
+			l.InboundRefs = append(l.InboundRefs, &ReferenceLink{
+				Name:    r.Name,
+				FieldID: r.Field.ID(),
+			})
+		}
+	}
+
+	if e.Mappings {
+		for _, mp := range f.Mappings {
+			l.Mappings = append(l.Mappings, &MappingLink{
+				Comment:  mp.Comment,
+				Warnings: mp.Warnings,
+				FieldID:  mp.Field.ID(),
+			})
+		}
+	}
+
+	if l.References == nil && l.InboundRefs == nil && l.Mappings == nil {
+		return nil
+	}
+
+	return &l
+}
+
+// Field returns the identified field or nil if it is not in the set.
+func (ms *Models) Field(id FieldID) *Field {
+	m := ms.Get(id.Model, id.Version)
+
+	if m == nil || m.Tables == nil {
+		return nil
+	}
+
+	t := m.Tables.Get(id.Table)
+
+	if t == nil || t.Fields == nil {
+		return nil
+	}
+
+	return t.Fields.Get(id.Field)
+}
+
+// Relink sets the model of the tables and the table of the fields, which are
+// not part of the JSON representation, and resolves the links of expanded
+// fields into references and mappings. Links to fields of models that are
+// not in the set remain unresolved in the field's Links.
+func (ms *Models) Relink() {
+	for _, m := range ms.l {
+		if m.Tables == nil {
+			continue
+		}
+
+		for _, t := range m.Tables.List() {
+			t.Model = m
+
+			if t.Fields == nil {
+				continue
+			}
+
+			for _, f := range t.Fields.List() {
+				f.Table = t
+			}
+		}
+	}
+
+	for _, m := range ms.l {
+		if m.Tables == nil {
+			continue
+		}
+
+		for _, t := range m.Tables.List() {
+			if t.Fields == nil {
+				continue
+			}
+
+			for _, f := range t.Fields.List() {
+				if f.Links != nil {
+					ms.relinkField(f)
+				}
+			}
+		}
+	}
+}
+
+func (ms *Models) relinkField(f *Field) {
+	if r := f.Links.References; r != nil {
+		if rf := ms.Field(r.FieldID); rf != nil {
+			f.References = &Reference{
+				Name:  r.Name,
+				Field: rf,
+			}
+		}
+	}
+
+	if len(f.Links.InboundRefs) > 0 {
+		f.InboundRefs = nil
+
+		for _, r := range f.Links.InboundRefs {
+			if rf := ms.Field(r.FieldID); rf != nil {
+				f.InboundRefs = append(f.InboundRefs, &Reference{
+					Name:  r.Name,
+					Field: rf,
+				})
+			}
+		}
+	}
+
+	if len(f.Links.Mappings) > 0 {
+		f.Mappings = nil
+
+		for _, mp := range f.Links.Mappings {
+			if mf := ms.Field(mp.FieldID); mf != nil {
+				f.Mappings = append(f.Mappings, &Mapping{
+					Field:    mf,
+					Comment:  mp.Comment,
+					Warnings: mp.Warnings,
+				})
+			}
+		}
+	}
+}
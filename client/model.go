@@ -27,24 +27,86 @@ type Model struct {
 	Schema  *Schema  `json:"-"`
 
 	Path string `json:"-"`
+
+	// Ref is the branch or tag of the repository the model was read from.
+	Ref string `json:"ref,omitempty"`
+
+	// Commit is the SHA1 of the commit the model was read from if the
+	// repository is a git repository.
+	Commit string `json:"commit,omitempty"`
+
+	// Qualified is true if the model is addressed by its version qualified
+	// with the ref, e.g. 2.0.0@develop. The models of the default ref of a
+	// repository are addressed by the plain version.
+	Qualified bool `json:"qualified,omitempty"`
+
+	// Problems found in the definition files while parsing the model.
+	Issues []*Issue `json:"-"`
+}
+
+// Issue is a problem found in a definition file of a model. The data the
+// issue refers to is ignored by the parser.
+type Issue struct {
+	Severity string `json:"severity"`
+	Rule     string `json:"rule"`
+
+	// Path of the file relative to the model directory.
+	Path string `json:"path"`
+	Line int    `json:"line,omitempty"`
+
+	Message string `json:"message"`
 }
 
 func (m *Model) String() string {
+	s := fmt.Sprintf("%s/%s", m.Name, m.Version)
+
 	if m.Label != "" {
-		return m.Label
+		s = m.Label
+	}
+
+	if m.Qualified {
+		s = fmt.Sprintf("%s (%s)", s, m.Ref)
+	}
+
+	return s
+}
+
+// QualifiedVersion returns the version the model is addressed by.
+func (m *Model) QualifiedVersion() string {
+	if m.Qualified {
+		return fmt.Sprintf("%s@%s", m.Version, m.Ref)
 	}
 
-	return fmt.Sprintf("%s/%s", m.Name, m.Version)
+	return m.Version
 }
 
 func (m *Model) URLPath() string {
-	return fmt.Sprintf("%s/%s", m.Name, m.Version)
+	return fmt.Sprintf("%s/%s", m.Name, m.QualifiedVersion())
 }
 
 func (m *Model) URLSlug() string {
+	if m.Qualified {
+		return fmt.Sprintf("%s-%s-%s", m.Name, m.Version, m.Ref)
+	}
+
 	return fmt.Sprintf("%s-%s", m.Name, m.Version)
 }
 
+// UnmarshalJSON decodes the model and relinks its tables and fields.
+func (m *Model) UnmarshalJSON(b []byte) error {
+	type model Model
+
+	if err := json.Unmarshal(b, (*model)(m)); err != nil {
+		return err
+	}
+
+	ms := &Models{}
+	ms.Add(m)
+	ms.Relink()
+
+	return nil
+}
+
 type Table struct {
 	Name        string  `json:"name"`
 	Label       string  `json:"label"`
@@ -53,6 +115,12 @@ type Table struct {
 
 	Model *Model `json:"-"`
 	Attrs Attrs  `json:"-"`
+
+	// The table this was renamed from in the previous version.
+	RenamedFrom *Table `json:"-"`
+
+	// The table this was renamed to in the next version.
+	RenamedTo *Table `json:"-"`
 }
 
 func (t *Table) String() string {
@@ -100,6 +168,10 @@ type Field struct {
 	// Fields that reference this field.
 	InboundRefs []*Reference `json:"-"`
 
+	// Links of the expanded representation. They are resolved into the
+	// references and mappings above when the models are decoded.
+	Links *FieldLinks `json:"links,omitempty"`
+
 	Attrs Attrs `json:"-"`
 }
 
@@ -126,7 +198,7 @@ type Models struct {
 }
 
 func (ms *Models) Len() int {
-	return len(ms.m)
+	return len(ms.l)
 }
 
 // TODO: handle version numbers correctly.
@@ -140,7 +212,16 @@ func (ms *Models) Less(i, j int) bool {
 		return false
 	}
 
-	return a.Version < b.Version
+	if a.Version != b.Version {
+		return a.Version < b.Version
+	}
+
+	// The default ref comes first.
+	if a.Qualified != b.Qualified {
+		return !a.Qualified
+	}
+
+	return a.Ref < b.Ref
 }
 
 func (ms *Models) Swap(i, j int) {
@@ -157,23 +238,35 @@ func (ms *Models) Keys() []string {
 	return keys
 }
 
+// Add adds the model under its qualified version. Models of the default
+// ref are also added under the version qualified with the ref.
 func (ms *Models) Add(m *Model) {
 	n := strings.ToLower(m.Name)
-	v := strings.ToLower(m.Version)
+	v := strings.ToLower(m.QualifiedVersion())
 
 	if ms.m == nil {
 		ms.m = make(map[string]map[string]*Model)
 	}
 
-	if ix, ok := ms.m[n]; !ok {
-		ms.m[n] = map[string]*Model{v: m}
-		ms.l = append(ms.l, m)
-		sort.Sort(ms)
-	} else if _, ok := ix[v]; !ok {
-		ix[v] = m
-		ms.l = append(ms.l, m)
-		sort.Sort(ms)
+	ix, ok := ms.m[n]
+
+	if !ok {
+		ix = make(map[string]*Model)
+		ms.m[n] = ix
+	}
+
+	if _, ok := ix[v]; ok {
+		return
 	}
+
+	ix[v] = m
+
+	if !m.Qualified && m.Ref != "" {
+		ix[strings.ToLower(m.Version+"@"+m.Ref)] = m
+	}
+
+	ms.l = append(ms.l, m)
+	sort.Sort(ms)
 }
 
 func (ms *Models) Get(n, v string) *Model {
@@ -194,12 +287,13 @@ func (ms *Models) Versions(n string) []*Model {
 		return nil
 	}
 
-	i := 0
-	models := make([]*Model, len(ms.m[n]))
+	var models []*Model
 
-	for _, m := range ms.m[n] {
-		models[i] = m
-		i++
+	// The index also holds the ref aliases of the models.
+	for _, m := range ms.l {
+		if strings.ToLower(m.Name) == n {
+			models = append(models, m)
+		}
 	}
 
 	return models
@@ -238,6 +332,9 @@ func (ms *Models) UnmarshalJSON(b []byte) error {
 		ms.Add(m)
 	}
 
+	// Resolve the links across models.
+	ms.Relink()
+
 	return nil
 }
 
@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/json"
+	"sort"
 	"strings"
 )
 
@@ -11,6 +12,10 @@ import (
 type Mapping struct {
 	Field   *Field
 	Comment string
+
+	// Warnings about values that may be lost or rejected when converted
+	// between the schemas of the two fields.
+	Warnings []string
 }
 
 // Reference declares that the source field is a reference to the target field.
@@ -45,32 +50,68 @@ type Schema struct {
 	Indexes      map[string]*Index
 }
 
-func (s *Schema) MarshalJSON() ([]byte, error) {
-	pks := make([]*PrimaryKey, len(s.PrimaryKeys))
-	uniqs := make([]*Unique, len(s.Uniques))
-	indexes := make([]*Index, len(s.Indexes))
+// PrimaryKeyList returns the primary keys sorted by name.
+func (s *Schema) PrimaryKeyList() []*PrimaryKey {
+	names := make([]string, 0, len(s.PrimaryKeys))
+
+	for n := range s.PrimaryKeys {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
 
-	i := 0
+	l := make([]*PrimaryKey, len(names))
 
-	for _, pk := range s.PrimaryKeys {
-		pks[i] = pk
-		i++
+	for i, n := range names {
+		l[i] = s.PrimaryKeys[n]
 	}
 
-	i = 0
+	return l
+}
 
-	for _, un := range s.Uniques {
-		uniqs[i] = un
-		i++
+// UniqueList returns the unique constraints sorted by name.
+func (s *Schema) UniqueList() []*Unique {
+	names := make([]string, 0, len(s.Uniques))
+
+	for n := range s.Uniques {
+		names = append(names, n)
 	}
 
-	i = 0
+	sort.Strings(names)
+
+	l := make([]*Unique, len(names))
 
-	for _, idx := range s.Indexes {
-		indexes[i] = idx
-		i++
+	for i, n := range names {
+		l[i] = s.Uniques[n]
 	}
 
+	return l
+}
+
+// IndexList returns the indexes sorted by name.
+func (s *Schema) IndexList() []*Index {
+	names := make([]string, 0, len(s.Indexes))
+
+	for n := range s.Indexes {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	l := make([]*Index, len(names))
+
+	for i, n := range names {
+		l[i] = s.Indexes[n]
+	}
+
+	return l
+}
+
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	pks := s.PrimaryKeyList()
+	uniqs := s.UniqueList()
+	indexes := s.IndexList()
+
 	aux := map[string]interface{}{
 		"indexes": indexes,
 		"constraints": map[string]interface{}{
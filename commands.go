@@ -0,0 +1,372 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+	"github.com/sirupsen/logrus"
+)
+
+// Subcommands that run in place of the service when named by the first
+// argument. Each command receives the remaining arguments and returns the
+// exit code.
+var commands = map[string]func(args []string) int{
+	"ddl":      ddlCommand,
+	"compare":  compareCommand,
+	"validate": validateCommand,
+}
+
+// ddlCommand writes the DDL of a model found in a local directory.
+func ddlCommand(args []string) int {
+	var (
+		dialect string
+		path    string
+	)
+
+	fs := flag.NewFlagSet("ddl", flag.ExitOnError)
+
+	fs.StringVar(&dialect, "dialect", defaultDialect, fmt.Sprintf("SQL dialect, one of: %s.", strings.Join(DialectNames(), ", ")))
+	fs.StringVar(&path, "path", ".", "Local directory containing the model definitions.")
+
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: data-models ddl [options] <model> <version>")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	d := GetDialect(dialect)
+
+	if d == nil {
+		fmt.Fprintf(os.Stderr, "unknown dialect %q\n", dialect)
+		return 2
+	}
+
+	path, _ = filepath.Abs(path)
+
+	m := loadModels(path).Get(fs.Arg(0), fs.Arg(1))
+
+	if m == nil {
+		fmt.Fprintf(os.Stderr, "no model %s/%s in %s\n", fs.Arg(0), fs.Arg(1), path)
+		return 1
+	}
+
+	WriteModelDDL(os.Stdout, m, d)
+
+	return 0
+}
+
+// Exit code of the compare command if the changes are at least as severe as
+// the -fail-on class.
+const compareFailCode = 3
+
+// compareCommand classifies the differences between two models found in a
+// local directory. The exit code makes it usable as a CI gate.
+func compareCommand(args []string) int {
+	var (
+		path    string
+		format  string
+		renames string
+		failOn  string
+	)
+
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+
+	fs.StringVar(&path, "path", ".", "Local directory containing the model definitions.")
+	fs.StringVar(&format, "format", "text", "Output format, one of: text, json, markdown.")
+	fs.StringVar(&renames, "renames", "suggest", "Rename detection, one of: off, suggest, apply.")
+	fs.StringVar(&failOn, "fail-on", "breaking", "Exit with 3 if the changes are at least this severe, one of: breaking, additive, cosmetic.")
+
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: data-models compare [options] <model1> <version1> <model2> <version2>")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if fs.NArg() != 4 {
+		fs.Usage()
+		return 2
+	}
+
+	mode, ok := renameModes[strings.ToLower(renames)]
+
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown renames mode %q\n", renames)
+		return 2
+	}
+
+	threshold, ok := ParseChangeClass(failOn)
+
+	if !ok || threshold == Unchanged {
+		fmt.Fprintf(os.Stderr, "unknown change class %q\n", failOn)
+		return 2
+	}
+
+	path, _ = filepath.Abs(path)
+
+	models := loadModels(path)
+
+	m1 := models.Get(fs.Arg(0), fs.Arg(1))
+
+	if m1 == nil {
+		fmt.Fprintf(os.Stderr, "no model %s/%s in %s\n", fs.Arg(0), fs.Arg(1), path)
+		return 1
+	}
+
+	m2 := models.Get(fs.Arg(2), fs.Arg(3))
+
+	if m2 == nil {
+		fmt.Fprintf(os.Stderr, "no model %s/%s in %s\n", fs.Arg(2), fs.Arg(3), path)
+		return 1
+	}
+
+	diff := DiffModels(m1, m2, mode)
+
+	switch format {
+	case "text":
+		diff.Compatibility.WriteText(os.Stdout)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+
+		if err := enc.Encode(diff); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	case "md", "markdown":
+		diff.WriteMarkdown(os.Stdout)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", format)
+		return 2
+	}
+
+	if diff.Compatibility.Verdict >= threshold {
+		return compareFailCode
+	}
+
+	return 0
+}
+
+// validationIssue is an issue of a model with the path relative to the
+// validated directory.
+type validationIssue struct {
+	Model   string `json:"model"`
+	Version string `json:"version"`
+	*dms.Issue
+}
+
+func (i *validationIssue) Location() string {
+	if i.Line > 0 {
+		return fmt.Sprintf("%s:%d", i.Path, i.Line)
+	}
+
+	return i.Path
+}
+
+// validationIssues returns the issues of the model relative to the root.
+func validationIssues(root string, m *dms.Model) []*validationIssue {
+	var issues []*validationIssue
+
+	for _, i := range sortedIssues(m) {
+		c := *i
+
+		if rel, err := filepath.Rel(root, filepath.Join(m.Path, i.Path)); err == nil {
+			c.Path = rel
+		}
+
+		issues = append(issues, &validationIssue{
+			Model:   m.Name,
+			Version: m.Version,
+			Issue:   &c,
+		})
+	}
+
+	return issues
+}
+
+func writeValidationText(w io.Writer, issues []*validationIssue, models int) {
+	counts := map[string]int{}
+
+	for _, i := range issues {
+		fmt.Fprintf(w, "%s: %s: %s [%s]\n", i.Location(), i.Severity, i.Message, i.Rule)
+		counts[i.Severity]++
+	}
+
+	fmt.Fprintf(w, "%d errors, %d warnings, %d info in %d models\n", counts[severityError], counts[severityWarning], counts[severityInfo], models)
+}
+
+// JUnit report, one test suite per model and one test case per issue. Only
+// errors are failures.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name          `xml:"testsuites"`
+	Suites  []*junitTestSuite `xml:"testsuite"`
+}
+
+func writeValidationJUnit(w io.Writer, root string, models []*dms.Model) error {
+	report := junitTestSuites{}
+
+	for _, m := range models {
+		suite := &junitTestSuite{
+			Name: m.URLPath(),
+		}
+
+		for _, i := range validationIssues(root, m) {
+			tc := &junitTestCase{
+				Name:      fmt.Sprintf("%s %s", i.Rule, i.Location()),
+				Classname: m.URLPath(),
+			}
+
+			if i.Severity == severityError {
+				tc.Failure = &junitFailure{
+					Message: i.Message,
+					Type:    i.Rule,
+					Text:    fmt.Sprintf("%s: %s", i.Location(), i.Message),
+				}
+
+				suite.Failures++
+			} else {
+				tc.SystemOut = fmt.Sprintf("%s: %s: %s", i.Location(), i.Severity, i.Message)
+			}
+
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+
+		// Suites without issues pass a single case.
+		if len(suite.TestCases) == 0 {
+			suite.TestCases = append(suite.TestCases, &junitTestCase{
+				Name:      "parse",
+				Classname: m.URLPath(),
+			})
+		}
+
+		suite.Tests = len(suite.TestCases)
+		report.Suites = append(report.Suites, suite)
+	}
+
+	fmt.Fprint(w, xml.Header)
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+
+	return nil
+}
+
+// validateCommand parses the models in a local directory and reports the
+// issues found. It exits with 1 if any issue is an error.
+func validateCommand(args []string) int {
+	var format string
+
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+
+	fs.StringVar(&format, "format", "text", "Output format, one of: text, json, junit.")
+
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: data-models validate [options] <dir>")
+		fs.PrintDefaults()
+	}
+
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	switch format {
+	case "text", "json", "junit":
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", format)
+		return 2
+	}
+
+	root, _ := filepath.Abs(fs.Arg(0))
+
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		fmt.Fprintf(os.Stderr, "%s is not a directory\n", fs.Arg(0))
+		return 2
+	}
+
+	// Issues are reported below rather than logged.
+	logrus.SetOutput(ioutil.Discard)
+
+	models := loadModels(root).List()
+
+	if len(models) == 0 {
+		fmt.Fprintf(os.Stderr, "no models found in %s\n", fs.Arg(0))
+		return 1
+	}
+
+	var issues []*validationIssue
+
+	for _, m := range models {
+		issues = append(issues, validationIssues(root, m)...)
+	}
+
+	switch format {
+	case "text":
+		writeValidationText(os.Stdout, issues, len(models))
+	case "json":
+		if issues == nil {
+			issues = make([]*validationIssue, 0)
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+
+		if err := enc.Encode(issues); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	case "junit":
+		if err := writeValidationJUnit(os.Stdout, root, models); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+
+	for _, i := range issues {
+		if i.Severity == severityError {
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// ChangeClass describes the impact of a change on consumers of a model such
+// as ETL processes. The classes are ordered by severity.
+type ChangeClass int
+
+const (
+	// Unchanged is only used as the verdict of models without differences.
+	Unchanged ChangeClass = iota
+
+	// Cosmetic changes do not affect the data, e.g. a description.
+	Cosmetic
+
+	// Additive changes extend the model without invalidating existing
+	// data or queries, e.g. a new optional field.
+	Additive
+
+	// Breaking changes invalidate existing data or queries, e.g. a
+	// removed field or a narrowed length.
+	Breaking
+)
+
+var changeClassStrings = map[ChangeClass]string{
+	Unchanged: "unchanged",
+	Cosmetic:  "cosmetic",
+	Additive:  "additive",
+	Breaking:  "breaking",
+}
+
+var changeClassTitles = map[ChangeClass]string{
+	Cosmetic: "Cosmetic",
+	Additive: "Additive",
+	Breaking: "Breaking",
+}
+
+func (c ChangeClass) String() string {
+	return changeClassStrings[c]
+}
+
+func (c ChangeClass) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// ParseChangeClass returns the class by name.
+func ParseChangeClass(s string) (ChangeClass, bool) {
+	for c, n := range changeClassStrings {
+		if n == strings.ToLower(s) {
+			return c, true
+		}
+	}
+
+	return Unchanged, false
+}
+
+// ClassifiedChange is a single difference between two models.
+type ClassifiedChange struct {
+	Class ChangeClass `json:"class"`
+
+	// One of table, field, constraint or index.
+	Kind string `json:"kind"`
+
+	// Name of the table or table.field the change applies to or the key of
+	// the constraint or index.
+	Target string `json:"target"`
+
+	Description string `json:"description"`
+}
+
+func (c *ClassifiedChange) String() string {
+	return fmt.Sprintf("%s `%s` %s", c.Kind, c.Target, c.Description)
+}
+
+// Compatibility is the classification of the differences between two models.
+// The verdict is the most severe class of all changes.
+type Compatibility struct {
+	Verdict ChangeClass         `json:"verdict"`
+	Counts  map[string]int      `json:"counts"`
+	Changes []*ClassifiedChange `json:"changes"`
+}
+
+func (c *Compatibility) add(class ChangeClass, kind, target, desc string) {
+	c.Changes = append(c.Changes, &ClassifiedChange{
+		Class:       class,
+		Kind:        kind,
+		Target:      target,
+		Description: desc,
+	})
+
+	c.Counts[class.String()]++
+
+	if class > c.Verdict {
+		c.Verdict = class
+	}
+}
+
+// compareSize classifies a change of a size such as a length or precision.
+// Zero means unbounded.
+func compareSize(a, b int) ChangeClass {
+	switch {
+	case a == b:
+		return Unchanged
+	case b == 0:
+		return Additive
+	case a == 0, b < a:
+		return Breaking
+	default:
+		return Additive
+	}
+}
+
+// typeWidenings maps generic types to the generic types holding all of
+// their values.
+var typeWidenings = map[string]map[string]bool{
+	smallIntegerType: {integerType: true, bigIntegerType: true},
+	integerType:      {bigIntegerType: true},
+	floatType:        {doubleType: true},
+	stringType:       {textType: true},
+}
+
+// compareType classifies a change of the type of a field. A type widens to
+// a type holding all of its values.
+func compareType(a, b string) ChangeClass {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+
+	switch {
+	case a == b:
+		return Unchanged
+	case typeWidenings[genericTypes[a]][genericTypes[b]]:
+		return Additive
+	default:
+		return Breaking
+	}
+}
+
+// widensField returns true if the type and sizes of the field are changed
+// such that all values of the field from are kept.
+func widensField(from, to *dms.Field) bool {
+	if compareType(from.Type, to.Type) == Breaking {
+		return false
+	}
+
+	if compareSize(from.Length, to.Length) == Breaking || compareSize(from.Precision, to.Precision) == Breaking {
+		return false
+	}
+
+	return from.Scale <= to.Scale
+}
+
+// Attributes of fields classified by their schema rather than as cosmetic.
+var classifiedFieldAttrs = map[string]bool{
+	"type":      true,
+	"length":    true,
+	"precision": true,
+	"scale":     true,
+	"default":   true,
+	"required":  true,
+}
+
+// classifyFieldChange classifies the differences of a field present in both
+// models, possibly under a different name.
+func (c *Compatibility) classifyFieldChange(af, bf *dms.Field, ats, bts *tableSchema, attrs *Diff) {
+	target := bf.Table.Name + "." + bf.Name
+
+	switch compareType(af.Type, bf.Type) {
+	case Breaking:
+		c.add(Breaking, "field", target, fmt.Sprintf("type changed from %s to %s", af.Type, bf.Type))
+	case Additive:
+		c.add(Additive, "field", target, fmt.Sprintf("type widened from %s to %s", af.Type, bf.Type))
+	}
+
+	sizes := []struct {
+		name string
+		a, b int
+	}{
+		{"length", af.Length, bf.Length},
+		{"precision", af.Precision, bf.Precision},
+	}
+
+	for _, s := range sizes {
+		switch compareSize(s.a, s.b) {
+		case Breaking:
+			c.add(Breaking, "field", target, fmt.Sprintf("%s narrowed from %d to %d", s.name, s.a, s.b))
+		case Additive:
+			c.add(Additive, "field", target, fmt.Sprintf("%s widened from %d to %d", s.name, s.a, s.b))
+		}
+	}
+
+	// A scale of zero is no fractional digits rather than unbounded.
+	if af.Scale > bf.Scale {
+		c.add(Breaking, "field", target, fmt.Sprintf("scale narrowed from %d to %d", af.Scale, bf.Scale))
+	} else if af.Scale < bf.Scale {
+		c.add(Additive, "field", target, fmt.Sprintf("scale widened from %d to %d", af.Scale, bf.Scale))
+	}
+
+	if af.Default != bf.Default {
+		c.add(Additive, "field", target, fmt.Sprintf("default changed from %q to %q", af.Default, bf.Default))
+	}
+
+	if an, bn := isNotNull(af, ats), isNotNull(bf, bts); !an && bn {
+		c.add(Breaking, "field", target, "became required")
+	} else if an && !bn {
+		c.add(Additive, "field", target, "became optional")
+	}
+
+	if attrs == nil {
+		return
+	}
+
+	// The schema attributes are classified above, the remaining attributes
+	// only describe the field.
+	for _, k := range attrs.Added {
+		if !classifiedFieldAttrs[k] {
+			c.add(Cosmetic, "field", target, fmt.Sprintf("%s added", k))
+		}
+	}
+
+	for _, k := range attrs.Removed {
+		if !classifiedFieldAttrs[k] {
+			c.add(Cosmetic, "field", target, fmt.Sprintf("%s removed", k))
+		}
+	}
+
+	for _, k := range attrs.ChangedKeys() {
+		if !classifiedFieldAttrs[k] {
+			c.add(Cosmetic, "field", target, fmt.Sprintf("%s changed", k))
+		}
+	}
+}
+
+// ClassifyDiff classifies each difference of the models as breaking,
+// additive or cosmetic.
+func ClassifyDiff(diff *ModelDiff) *Compatibility {
+	c := &Compatibility{
+		Counts: map[string]int{
+			Breaking.String(): 0,
+			Additive.String(): 0,
+			Cosmetic.String(): 0,
+		},
+		Changes: make([]*ClassifiedChange, 0),
+	}
+
+	a, b := diff.From, diff.To
+
+	aschema := indexSchema(a.Schema)
+	bschema := indexSchema(b.Schema)
+
+	for _, n := range diff.Tables.Removed {
+		c.add(Breaking, "table", n, "removed")
+	}
+
+	for _, n := range diff.Tables.RenamedKeys() {
+		c.add(Breaking, "table", n, fmt.Sprintf("renamed to %s", diff.Tables.Renamed[n]))
+	}
+
+	for _, n := range diff.Tables.Added {
+		c.add(Additive, "table", n, "added")
+	}
+
+	// Tables present in both models by their old and new names.
+	before := make(map[string]bool, len(diff.Fields))
+	after := make(map[string]bool, len(diff.Fields))
+
+	for _, td := range diff.Fields {
+		from := td.Table
+
+		if td.RenamedFrom != "" {
+			from = td.RenamedFrom
+		}
+
+		before[strings.ToLower(from)] = true
+		after[strings.ToLower(td.Table)] = true
+
+		at := a.Tables.Get(from)
+		bt := b.Tables.Get(td.Table)
+
+		ats := schemaForTable(aschema, from)
+		bts := schemaForTable(bschema, td.Table)
+
+		for _, n := range td.Fields.Removed {
+			c.add(Breaking, "field", from+"."+n, "removed")
+		}
+
+		for _, n := range td.Fields.RenamedKeys() {
+			c.add(Breaking, "field", from+"."+n, fmt.Sprintf("renamed to %s", td.Fields.Renamed[n]))
+		}
+
+		// New fields break existing loads if they must be populated.
+		for _, n := range td.Fields.Added {
+			if f := bt.Fields.Get(n); isNotNull(f, bts) && f.Default == "" {
+				c.add(Breaking, "field", td.Table+"."+n, "added as required without a default")
+			} else {
+				c.add(Additive, "field", td.Table+"."+n, "added")
+			}
+		}
+
+		changes := make(map[string]*Diff, len(td.Changes))
+
+		for _, fd := range td.Changes {
+			changes[fd.Field] = fd.Attrs
+		}
+
+		for _, n := range td.Fields.Matches {
+			c.classifyFieldChange(at.Fields.Get(n), bt.Fields.Get(n), ats, bts, changes[n])
+		}
+
+		for _, n := range td.Fields.RenamedKeys() {
+			to := td.Fields.Renamed[n]
+			c.classifyFieldChange(at.Fields.Get(n), bt.Fields.Get(to), ats, bts, changes[to])
+		}
+	}
+
+	// Constraints of added and removed tables are part of the table change.
+	// Not null constraints are classified with the fields.
+	acons := modelConstraints(a.Schema)
+	bcons := modelConstraints(b.Schema)
+
+	for _, k := range diff.Constraints.Added {
+		if cn := bcons[k]; after[strings.ToLower(cn.Table)] && cn.Kind != "NOT NULL" {
+			c.add(Breaking, "constraint", k, "added, existing data may violate it")
+		}
+	}
+
+	for _, k := range diff.Constraints.Removed {
+		if cn := acons[k]; before[strings.ToLower(cn.Table)] && cn.Kind != "NOT NULL" {
+			c.add(Additive, "constraint", k, "removed")
+		}
+	}
+
+	for _, k := range diff.Constraints.ChangedKeys() {
+		if cn := bcons[k]; after[strings.ToLower(cn.Table)] && cn.Kind != "NOT NULL" {
+			c.add(Breaking, "constraint", k, "changed, existing data may violate it")
+		}
+	}
+
+	// Only unique indexes constrain the data, other indexes extend the
+	// schema.
+	bidxs := modelIndexes(b.Schema)
+	aidxs := modelIndexes(a.Schema)
+
+	for _, k := range append(diff.Indexes.Added, diff.Indexes.ChangedKeys()...) {
+		idx := bidxs[k]
+
+		if !after[strings.ToLower(idx.Table)] {
+			continue
+		}
+
+		if idx.Unique && (aidxs[k] == nil || !aidxs[k].Unique) {
+			c.add(Breaking, "index", k, "made unique, existing data may violate it")
+		} else {
+			c.add(Additive, "index", k, "added or changed")
+		}
+	}
+
+	for _, k := range diff.Indexes.Removed {
+		idx := aidxs[k]
+
+		if !before[strings.ToLower(idx.Table)] {
+			continue
+		}
+
+		if idx.Unique {
+			c.add(Additive, "index", k, "removed, duplicate values are allowed")
+		} else {
+			c.add(Cosmetic, "index", k, "removed")
+		}
+	}
+
+	return c
+}
+
+// WriteMarkdown writes the changes grouped by class, most severe first.
+func (c *Compatibility) WriteMarkdown(w io.Writer) {
+	if len(c.Changes) == 0 {
+		fmt.Fprint(w, "\nNo differences.\n")
+		return
+	}
+
+	for _, class := range []ChangeClass{Breaking, Additive, Cosmetic} {
+		if c.Counts[class.String()] == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n## %s\n\n", changeClassTitles[class])
+
+		for _, ch := range c.Changes {
+			if ch.Class == class {
+				fmt.Fprintf(w, "- %s\n", ch)
+			}
+		}
+	}
+}
+
+// WriteText writes the verdict followed by one change per line.
+func (c *Compatibility) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "verdict: %s\n", c.Verdict)
+
+	for _, ch := range c.Changes {
+		fmt.Fprintf(w, "%-9s %s %s %s\n", ch.Class, ch.Kind, ch.Target, ch.Description)
+	}
+}
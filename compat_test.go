@@ -0,0 +1,243 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// testModelFiles returns the definitions files of the test model with the
+// fields and schema rows of its person table. The rows start with the field
+// name.
+func testModelFiles(version, fields, schema string) map[string]string {
+	prefix := "test," + version + ",person,"
+
+	rows := func(s string) string {
+		s = strings.TrimSuffix(s, "\n")
+		return prefix + strings.Replace(s, "\n", "\n"+prefix, -1) + "\n"
+	}
+
+	return map[string]string{
+		"models.csv": "model,version,label,description,url\n" +
+			"test," + version + ",Test,Test model,\n",
+		"tables.csv": "model,version,table,description\n" +
+			"test," + version + ",person,Demographics\n",
+		"fields.csv": "model,version,table,field,description,required\n" + rows(fields),
+		"schema.csv": "model,version,table,field,type,length,precision,scale,default\n" + rows(schema),
+	}
+}
+
+// loadTestModel writes the definitions files of the test model to a
+// directory and parses it.
+func loadTestModel(t *testing.T, version, fields, schema string) *dms.Model {
+	dir := t.TempDir()
+
+	for n, s := range testModelFiles(version, fields, schema) {
+		if err := ioutil.WriteFile(filepath.Join(dir, n), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := loadModels(dir).Get("test", version)
+
+	if m == nil {
+		t.Fatalf("model test %s not parsed", version)
+	}
+
+	return m
+}
+
+func TestClassifyDiff(t *testing.T) {
+	fields := "person_id,Person id,yes\n" +
+		"weight,Weight,no\n"
+
+	schema := "person_id,integer,,,,\n" +
+		"weight,decimal,10,8,2,\n"
+
+	tests := []struct {
+		name    string
+		fields  string
+		schema  string
+		verdict ChangeClass
+		changes []string
+	}{
+		{
+			"unchanged",
+			fields,
+			schema,
+			Unchanged,
+			nil,
+		},
+		{
+			"description",
+			"person_id,Person id,yes\n" +
+				"weight,Weight in kg,no\n",
+			schema,
+			Cosmetic,
+			[]string{"cosmetic: field `person.weight` description changed"},
+		},
+		{
+			"type",
+			fields,
+			"person_id,integer,,,,\n" +
+				"weight,integer,,,,\n",
+			Breaking,
+			[]string{
+				"breaking: field `person.weight` type changed from decimal to integer",
+				"additive: field `person.weight` length widened from 10 to 0",
+				"additive: field `person.weight` precision widened from 8 to 0",
+				"breaking: field `person.weight` scale narrowed from 2 to 0",
+			},
+		},
+		{
+			"type widened",
+			fields,
+			"person_id,bigint,,,,\n" +
+				"weight,decimal,10,8,2,\n",
+			Additive,
+			[]string{"additive: field `person.person_id` type widened from integer to bigint"},
+		},
+		{
+			"scale narrowed to zero",
+			fields,
+			"person_id,integer,,,,\n" +
+				"weight,decimal,10,8,0,\n",
+			Breaking,
+			[]string{"breaking: field `person.weight` scale narrowed from 2 to 0"},
+		},
+		{
+			"scale widened",
+			fields,
+			"person_id,integer,,,,\n" +
+				"weight,decimal,10,8,4,\n",
+			Additive,
+			[]string{"additive: field `person.weight` scale widened from 2 to 4"},
+		},
+		{
+			"length narrowed",
+			fields,
+			"person_id,integer,,,,\n" +
+				"weight,decimal,5,8,2,\n",
+			Breaking,
+			[]string{"breaking: field `person.weight` length narrowed from 10 to 5"},
+		},
+		{
+			"default",
+			fields,
+			"person_id,integer,,,,\n" +
+				"weight,decimal,10,8,2,0\n",
+			Additive,
+			[]string{"additive: field `person.weight` default changed from \"\" to \"0\""},
+		},
+		{
+			"became required",
+			"person_id,Person id,yes\n" +
+				"weight,Weight,yes\n",
+			schema,
+			Breaking,
+			[]string{"breaking: field `person.weight` became required"},
+		},
+		{
+			"optional field added",
+			fields + "height,Height,no\n",
+			schema + "height,decimal,10,8,2,\n",
+			Additive,
+			[]string{"additive: field `person.height` added"},
+		},
+		{
+			"required field added",
+			fields + "height,Height,yes\n",
+			schema + "height,decimal,10,8,2,\n",
+			Breaking,
+			[]string{"breaking: field `person.height` added as required without a default"},
+		},
+		{
+			"field removed",
+			"person_id,Person id,yes\n",
+			"person_id,integer,,,,\n",
+			Breaking,
+			[]string{"breaking: field `person.weight` removed"},
+		},
+	}
+
+	a := loadTestModel(t, "1.0.0", fields, schema)
+
+	for _, test := range tests {
+		b := loadTestModel(t, "2.0.0", test.fields, test.schema)
+
+		c := ClassifyDiff(DiffModels(a, b, RenamesOff))
+
+		if c.Verdict != test.verdict {
+			t.Errorf("%s: expected verdict %s, got %s", test.name, test.verdict, c.Verdict)
+		}
+
+		if len(c.Changes) != len(test.changes) {
+			t.Errorf("%s: expected %d changes, got %d: %v", test.name, len(test.changes), len(c.Changes), c.Changes)
+			continue
+		}
+
+		for i, s := range test.changes {
+			if cs := c.Changes[i].Class.String() + ": " + c.Changes[i].String(); cs != s {
+				t.Errorf("%s: expected change %q, got %q", test.name, s, cs)
+			}
+		}
+	}
+}
+
+func TestClassifyIndexes(t *testing.T) {
+	fields := "person_id,Person id,yes\n" +
+		"weight,Weight,no\n"
+
+	schema := "person_id,integer,,,,\n" +
+		"weight,decimal,10,8,2,\n"
+
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		verdict ChangeClass
+		change  string
+	}{
+		{"added", "", "no", Additive, "additive: index `person (weight)` added or changed"},
+		{"made unique", "no", "yes", Breaking, "breaking: index `person (weight)` made unique, existing data may violate it"},
+		{"made not unique", "yes", "no", Additive, "additive: index `person (weight)` added or changed"},
+		{"unique removed", "yes", "", Additive, "additive: index `person (weight)` removed, duplicate values are allowed"},
+		{"removed", "no", "", Cosmetic, "cosmetic: index `person (weight)` removed"},
+	}
+
+	// The unique attribute of the index or empty for no index.
+	load := func(version, unique string) *dms.Model {
+		m := loadTestModel(t, version, fields, schema)
+
+		if unique != "" {
+			m.Schema.AddIndex(dms.Attrs{
+				"table":  "person",
+				"field":  "weight",
+				"name":   "idx_person_weight",
+				"unique": unique,
+			})
+		}
+
+		return m
+	}
+
+	for _, test := range tests {
+		c := ClassifyDiff(DiffModels(load("1.0.0", test.from), load("2.0.0", test.to), RenamesOff))
+
+		if c.Verdict != test.verdict {
+			t.Errorf("%s: expected verdict %s, got %s", test.name, test.verdict, c.Verdict)
+		}
+
+		if len(c.Changes) != 1 {
+			t.Errorf("%s: expected 1 change, got %d: %v", test.name, len(c.Changes), c.Changes)
+			continue
+		}
+
+		if cs := c.Changes[0].Class.String() + ": " + c.Changes[0].String(); cs != test.change {
+			t.Errorf("%s: expected change %q, got %q", test.name, test.change, cs)
+		}
+	}
+}
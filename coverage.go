@@ -0,0 +1,269 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Mapping statuses of fields and tables.
+const (
+	statusMapped    = "mapped"
+	statusUnmapped  = "unmapped"
+	statusPartial   = "partial"
+	statusOneToMany = "one-to-many"
+	statusManyToOne = "many-to-one"
+)
+
+const (
+	sideSource = "source"
+	sideTarget = "target"
+)
+
+// FieldCoverage lists the fields of the other model a field is mapped to. A
+// source field mapped to multiple target fields is one-to-many and a target
+// field mapped from multiple source fields is many-to-one.
+// Warnings of the mappings are prefixed with the field of the other model.
+type FieldCoverage struct {
+	Field    string   `json:"field"`
+	Status   string   `json:"status"`
+	MappedTo []string `json:"mapped_to"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// TableCoverage is the share of the fields of a table that are mapped.
+type TableCoverage struct {
+	Table    string           `json:"table"`
+	Status   string           `json:"status"`
+	Mapped   int              `json:"mapped"`
+	Total    int              `json:"total"`
+	Coverage float64          `json:"coverage"`
+	Fields   []*FieldCoverage `json:"fields"`
+}
+
+// ModelCoverage is the mapping coverage of one of the models.
+type ModelCoverage struct {
+	Model    string           `json:"model"`
+	Version  string           `json:"version"`
+	Mapped   int              `json:"mapped"`
+	Total    int              `json:"total"`
+	Coverage float64          `json:"coverage"`
+	Tables   []*TableCoverage `json:"tables"`
+
+	model *dms.Model
+}
+
+// MappingCoverage reports how completely the fields of two models are mapped
+// to each other.
+type MappingCoverage struct {
+	Mappings int            `json:"mappings"`
+	Warnings int            `json:"warnings"`
+	Source   *ModelCoverage `json:"source"`
+	Target   *ModelCoverage `json:"target"`
+}
+
+// percent returns the share in percent rounded to one decimal.
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return math.Floor(float64(n)/float64(total)*1000+0.5) / 10
+}
+
+// mappingsTo returns the mappings of the field to fields of the other model.
+func mappingsTo(f *dms.Field, other *dms.Model) []*dms.Mapping {
+	var mappings []*dms.Mapping
+
+	for _, mp := range f.Mappings {
+		if mp.Field.Table.Model == other {
+			mappings = append(mappings, mp)
+		}
+	}
+
+	return mappings
+}
+
+// modelCoverage returns the coverage of the model on the side of the
+// mappings along with the number of mappings and the number of their
+// warnings.
+func modelCoverage(m, other *dms.Model, side string) (*ModelCoverage, int, int) {
+	multiple := statusOneToMany
+
+	if side == sideTarget {
+		multiple = statusManyToOne
+	}
+
+	c := &ModelCoverage{
+		Model:   m.Name,
+		Version: m.QualifiedVersion(),
+		Tables:  make([]*TableCoverage, 0),
+		model:   m,
+	}
+
+	var mappings, warnings int
+
+	for _, t := range m.Tables.List() {
+		tc := &TableCoverage{
+			Table:  t.Name,
+			Fields: make([]*FieldCoverage, 0),
+		}
+
+		for _, f := range t.Fields.List() {
+			fc := &FieldCoverage{
+				Field:    f.Name,
+				Status:   statusUnmapped,
+				MappedTo: make([]string, 0),
+			}
+
+			seen := make(map[*dms.Field]bool)
+
+			for _, mp := range mappingsTo(f, other) {
+				mf := mp.Field
+				name := mf.Table.Name + "." + mf.Name
+
+				mappings++
+				warnings += len(mp.Warnings)
+
+				for _, w := range mp.Warnings {
+					fc.Warnings = append(fc.Warnings, name+": "+w)
+				}
+
+				if !seen[mf] {
+					seen[mf] = true
+					fc.MappedTo = append(fc.MappedTo, name)
+				}
+			}
+
+			switch {
+			case len(fc.MappedTo) > 1:
+				fc.Status = multiple
+			case len(fc.MappedTo) == 1:
+				fc.Status = statusMapped
+			}
+
+			if fc.Status != statusUnmapped {
+				tc.Mapped++
+			}
+
+			tc.Total++
+			tc.Fields = append(tc.Fields, fc)
+		}
+
+		switch {
+		case tc.Mapped == 0:
+			tc.Status = statusUnmapped
+		case tc.Mapped == tc.Total:
+			tc.Status = statusMapped
+		default:
+			tc.Status = statusPartial
+		}
+
+		tc.Coverage = percent(tc.Mapped, tc.Total)
+
+		c.Mapped += tc.Mapped
+		c.Total += tc.Total
+		c.Tables = append(c.Tables, tc)
+	}
+
+	c.Coverage = percent(c.Mapped, c.Total)
+
+	return c, mappings, warnings
+}
+
+// NewMappingCoverage computes the coverage of the mappings between the
+// source and target models in both directions.
+func NewMappingCoverage(source, target *dms.Model) *MappingCoverage {
+	sc, n, w := modelCoverage(source, target, sideSource)
+	tc, _, _ := modelCoverage(target, source, sideTarget)
+
+	return &MappingCoverage{
+		Mappings: n,
+		Warnings: w,
+		Source:   sc,
+		Target:   tc,
+	}
+}
+
+func (c *ModelCoverage) writeMarkdown(w io.Writer, side string) {
+	fmt.Fprintf(w, "\n## %s (%s)\n\n", c.model, side)
+
+	fmt.Fprintf(w, "%d of %d fields mapped (%.1f%%)\n", c.Mapped, c.Total, c.Coverage)
+
+	if len(c.Tables) == 0 {
+		return
+	}
+
+	fmt.Fprint(w, "\nTable | Status | Mapped | Coverage\n")
+	fmt.Fprint(w, "----- | ------ | ------ | --------\n")
+
+	for _, tc := range c.Tables {
+		fmt.Fprintf(w, "[%s](/models/%s/%s) | %s | %d / %d | %.1f%%\n", tc.Table, c.model.URLPath(), tc.Table, tc.Status, tc.Mapped, tc.Total, tc.Coverage)
+	}
+
+	fmt.Fprint(w, "\n### Fields\n\n")
+	fmt.Fprint(w, "Table | Field | Status | Mapped to | Warnings\n")
+	fmt.Fprint(w, "----- | ----- | ------ | --------- | --------\n")
+
+	for _, tc := range c.Tables {
+		for _, fc := range tc.Fields {
+			fmt.Fprintf(w, "%s | [%s](/models/%s/%s/%s) | %s | %s | %s\n", tc.Table, fc.Field, c.model.URLPath(), tc.Table, fc.Field, fc.Status, strings.Join(fc.MappedTo, ", "), strings.Join(fc.Warnings, "<br>"))
+		}
+	}
+}
+
+// WriteMarkdown writes the coverage of both models with a table of the
+// coverage per table followed by the status of each field.
+func (c *MappingCoverage) WriteMarkdown(w io.Writer) {
+	fmt.Fprintf(w, "# Mappings from %s to %s\n\n", c.Source.model, c.Target.model)
+
+	fmt.Fprintf(w, "- Mappings: %d\n", c.Mappings)
+	fmt.Fprintf(w, "- Warnings: %d\n", c.Warnings)
+	fmt.Fprintf(w, "- Source coverage: %.1f%%\n", c.Source.Coverage)
+	fmt.Fprintf(w, "- Target coverage: %.1f%%\n", c.Target.Coverage)
+
+	c.Source.writeMarkdown(w, sideSource)
+	c.Target.writeMarkdown(w, sideTarget)
+}
+
+// WriteCSV writes a row per field of both models. The coverage of the table
+// is repeated on the rows of its fields.
+func (c *MappingCoverage) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	cw.Write([]string{"side", "model", "version", "table", "table_status", "table_coverage", "field", "status", "mapped_to", "warnings"})
+
+	for _, s := range []struct {
+		side string
+		c    *ModelCoverage
+	}{
+		{sideSource, c.Source},
+		{sideTarget, c.Target},
+	} {
+		for _, tc := range s.c.Tables {
+			for _, fc := range tc.Fields {
+				cw.Write([]string{
+					s.side,
+					s.c.Model,
+					s.c.Version,
+					tc.Table,
+					tc.Status,
+					strconv.FormatFloat(tc.Coverage, 'f', 1, 64),
+					fc.Field,
+					fc.Status,
+					strings.Join(fc.MappedTo, ";"),
+					strings.Join(fc.Warnings, ";"),
+				})
+			}
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
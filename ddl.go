@@ -0,0 +1,505 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// tableSchema holds the schema components of a single table.
+type tableSchema struct {
+	PrimaryKey  *dms.PrimaryKey
+	Uniques     []*dms.Unique
+	ForeignKeys []*dms.ForeignKey
+	Indexes     []*dms.Index
+	NotNull     map[string]bool
+
+	// Fields of keys and indexes.
+	Keys map[string]bool
+}
+
+func (s *tableSchema) IsNotNull(f string) bool {
+	return s.NotNull[strings.ToLower(f)]
+}
+
+func (s *tableSchema) IsKey(f string) bool {
+	return s.Keys[strings.ToLower(f)]
+}
+
+// indexSchema groups the schema components of a model by table name.
+func indexSchema(s *dms.Schema) map[string]*tableSchema {
+	idx := make(map[string]*tableSchema)
+
+	get := func(t string) *tableSchema {
+		t = strings.ToLower(t)
+
+		if _, ok := idx[t]; !ok {
+			idx[t] = &tableSchema{
+				NotNull: make(map[string]bool),
+				Keys:    make(map[string]bool),
+			}
+		}
+
+		return idx[t]
+	}
+
+	if s == nil {
+		return idx
+	}
+
+	keys := func(ts *tableSchema, fields ...string) {
+		for _, f := range fields {
+			ts.Keys[strings.ToLower(f)] = true
+		}
+	}
+
+	for _, pk := range s.PrimaryKeys {
+		ts := get(pk.Table)
+		ts.PrimaryKey = pk
+		keys(ts, pk.Fields...)
+	}
+
+	for _, un := range s.Uniques {
+		ts := get(un.Table)
+		ts.Uniques = append(ts.Uniques, un)
+		keys(ts, un.Fields...)
+	}
+
+	for _, fk := range s.ForeignKeys {
+		ts := get(fk.SourceTable)
+		ts.ForeignKeys = append(ts.ForeignKeys, fk)
+		keys(ts, fk.SourceField)
+	}
+
+	for _, i := range s.Indexes {
+		ts := get(i.Table)
+		ts.Indexes = append(ts.Indexes, i)
+		keys(ts, i.Fields...)
+	}
+
+	for _, nn := range s.NotNullables {
+		get(nn.Table).NotNull[strings.ToLower(nn.Field)] = true
+	}
+
+	// Map iteration is random, sort for a stable output.
+	for _, ts := range idx {
+		sort.Sort(uniquesByName(ts.Uniques))
+		sort.Sort(foreignKeysByName(ts.ForeignKeys))
+		sort.Sort(indexesByName(ts.Indexes))
+	}
+
+	return idx
+}
+
+type uniquesByName []*dms.Unique
+
+func (s uniquesByName) Len() int           { return len(s) }
+func (s uniquesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s uniquesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type foreignKeysByName []*dms.ForeignKey
+
+func (s foreignKeysByName) Len() int { return len(s) }
+func (s foreignKeysByName) Less(i, j int) bool {
+	if s[i].Name == s[j].Name {
+		return s[i].SourceField < s[j].SourceField
+	}
+
+	return s[i].Name < s[j].Name
+}
+func (s foreignKeysByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+type indexesByName []*dms.Index
+
+func (s indexesByName) Len() int           { return len(s) }
+func (s indexesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s indexesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// sortTablesByDependency returns the tables of the model ordered such that
+// tables referenced by a foreign key come before the tables referencing them.
+// Ties are broken by name. Tables that are part of a reference cycle are
+// appended in name order once no other tables can be emitted.
+func sortTablesByDependency(m *dms.Model, schema map[string]*tableSchema) []*dms.Table {
+	tables := m.Tables.List()
+
+	deps := make(map[string]map[string]struct{}, len(tables))
+
+	for _, t := range tables {
+		k := strings.ToLower(t.Name)
+		deps[k] = make(map[string]struct{})
+
+		ts, ok := schema[k]
+
+		if !ok {
+			continue
+		}
+
+		for _, fk := range ts.ForeignKeys {
+			r := strings.ToLower(fk.TargetTable)
+
+			// Ignore self-references and references to unknown tables.
+			if r == k || m.Tables.Get(r) == nil {
+				continue
+			}
+
+			deps[k][r] = struct{}{}
+		}
+	}
+
+	var (
+		sorted  []*dms.Table
+		emitted = make(map[string]bool, len(tables))
+	)
+
+	for len(sorted) < len(tables) {
+		var progress bool
+
+		// Tables are already sorted by name.
+		for _, t := range tables {
+			k := strings.ToLower(t.Name)
+
+			if emitted[k] {
+				continue
+			}
+
+			ready := true
+
+			for r := range deps[k] {
+				if !emitted[r] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				emitted[k] = true
+				sorted = append(sorted, t)
+				progress = true
+			}
+		}
+
+		// Cycle, emit the first remaining table and continue.
+		if !progress {
+			for _, t := range tables {
+				k := strings.ToLower(t.Name)
+
+				if !emitted[k] {
+					emitted[k] = true
+					sorted = append(sorted, t)
+					break
+				}
+			}
+		}
+	}
+
+	return sorted
+}
+
+func quoteList(d Dialect, l []string) string {
+	q := make([]string, len(l))
+
+	for i, s := range l {
+		q[i] = d.Quote(s)
+	}
+
+	return strings.Join(q, ", ")
+}
+
+// constraintDef returns the constraint clause used in CREATE TABLE and
+// ALTER TABLE statements. The name is shortened to the dialect's limit.
+func constraintDef(d Dialect, name, kind string, fields []string) string {
+	if name == "" {
+		return fmt.Sprintf("%s (%s)", kind, quoteList(d, fields))
+	}
+
+	return fmt.Sprintf("CONSTRAINT %s %s (%s)", d.Quote(shortenIdent(d, name)), kind, quoteList(d, fields))
+}
+
+// foreignKey is a foreign key of a table. Composite keys are declared as
+// multiple rows sharing a name.
+type foreignKey struct {
+	Name         string
+	Table        string
+	Fields       []string
+	TargetTable  string
+	TargetFields []string
+}
+
+// groupForeignKeys combines the foreign keys of a table by name.
+func groupForeignKeys(fks []*dms.ForeignKey) []*foreignKey {
+	var (
+		i      int
+		groups []*foreignKey
+	)
+
+	for i < len(fks) {
+		fk := fks[i]
+
+		g := &foreignKey{
+			Name:         fk.Name,
+			Table:        fk.SourceTable,
+			Fields:       []string{fk.SourceField},
+			TargetTable:  fk.TargetTable,
+			TargetFields: []string{fk.TargetField},
+		}
+
+		// Unnamed keys are never combined.
+		for i++; fk.Name != "" && i < len(fks) && fks[i].Name == fk.Name; i++ {
+			g.Fields = append(g.Fields, fks[i].SourceField)
+			g.TargetFields = append(g.TargetFields, fks[i].TargetField)
+		}
+
+		groups = append(groups, g)
+	}
+
+	return groups
+}
+
+// foreignKeyDef returns the foreign key constraint clause.
+func foreignKeyDef(d Dialect, fk *foreignKey) string {
+	return fmt.Sprintf("%s REFERENCES %s (%s)",
+		constraintDef(d, fk.Name, "FOREIGN KEY", fk.Fields),
+		d.Quote(fk.TargetTable),
+		quoteList(d, fk.TargetFields))
+}
+
+// foreignKeyDefs returns the foreign key constraint clauses of the table.
+func foreignKeyDefs(d Dialect, ts *tableSchema) []string {
+	var defs []string
+
+	for _, fk := range groupForeignKeys(ts.ForeignKeys) {
+		defs = append(defs, foreignKeyDef(d, fk))
+	}
+
+	return defs
+}
+
+// Keywords of default values which are not quoted.
+var sqlDefaultKeywords = map[string]bool{
+	"null":              true,
+	"true":              true,
+	"false":             true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"localtime":         true,
+	"localtimestamp":    true,
+}
+
+// Function calls such as now() or nextval('seq').
+var sqlFunctionCall = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*\(.*\)$`)
+
+// defaultValue returns the default of the field as SQL. Numbers, keywords,
+// function calls and values already quoted are used as is, other values
+// are quoted as string literals.
+func defaultValue(d Dialect, v string) string {
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		return v
+	}
+
+	if sqlDefaultKeywords[strings.ToLower(v)] || sqlFunctionCall.MatchString(v) {
+		return v
+	}
+
+	if len(v) > 1 && strings.HasPrefix(v, "'") && strings.HasSuffix(v, "'") {
+		return v
+	}
+
+	return d.Literal(v)
+}
+
+// defaultKeyLength is the length of the string columns of keys and indexes
+// declared without a length in dialects with a key length limit.
+const defaultKeyLength = 255
+
+// keyField returns the field with a length the dialect can index if it is a
+// column of a key or index of an unbounded string type or of a string type
+// longer than the key length limit of the dialect.
+func keyField(d Dialect, f *dms.Field, ts *tableSchema) *dms.Field {
+	n := d.MaxKeyLength()
+
+	if n == 0 || !ts.IsKey(f.Name) {
+		return f
+	}
+
+	l := defaultKeyLength
+
+	if l > n {
+		l = n
+	}
+
+	switch genericType(f) {
+	case stringType:
+		if f.Length > 0 && f.Length <= n {
+			return f
+		}
+
+		if f.Length > n {
+			l = n
+		}
+	case textType:
+	default:
+		return f
+	}
+
+	kf := *f
+	kf.Type = stringType
+	kf.Length = l
+
+	return &kf
+}
+
+// columnDef returns the column definition of the field.
+func columnDef(d Dialect, f *dms.Field, ts *tableSchema) string {
+	f = keyField(d, f, ts)
+
+	def := fmt.Sprintf("%s %s", d.Quote(f.Name), d.Type(f))
+
+	if f.Default != "" {
+		def += " DEFAULT " + defaultValue(d, f.Default)
+	}
+
+	// Required fields are enforced as well as the declared constraints.
+	if f.Required || ts.IsNotNull(f.Name) {
+		def += " NOT NULL"
+	}
+
+	return def
+}
+
+// writeCreateTable writes the CREATE TABLE statement for the table including
+// the primary key and unique constraints. Foreign keys are only included if
+// the dialect requires them to be inline, otherwise they are written
+// separately so tables can be created in any order.
+func writeCreateTable(w io.Writer, d Dialect, t *dms.Table, ts *tableSchema) {
+	var defs []string
+
+	for _, f := range t.Fields.List() {
+		defs = append(defs, columnDef(d, f, ts))
+	}
+
+	if pk := ts.PrimaryKey; pk != nil {
+		defs = append(defs, constraintDef(d, pk.Name, "PRIMARY KEY", pk.Fields))
+	}
+
+	for _, un := range ts.Uniques {
+		defs = append(defs, constraintDef(d, un.Name, "UNIQUE", un.Fields))
+	}
+
+	if d.InlineForeignKeys() {
+		defs = append(defs, foreignKeyDefs(d, ts)...)
+	}
+
+	fmt.Fprintf(w, "CREATE TABLE %s (\n", d.Quote(t.Name))
+
+	for i, def := range defs {
+		if i < len(defs)-1 {
+			fmt.Fprintf(w, "    %s,\n", def)
+		} else {
+			fmt.Fprintf(w, "    %s\n", def)
+		}
+	}
+
+	fmt.Fprintln(w, ");")
+}
+
+// writeForeignKeys writes the ALTER TABLE statements for the foreign keys
+// of the table.
+func writeForeignKeys(w io.Writer, d Dialect, t *dms.Table, ts *tableSchema) {
+	for _, def := range foreignKeyDefs(d, ts) {
+		fmt.Fprintf(w, "ALTER TABLE %s ADD %s;\n", d.Quote(t.Name), def)
+	}
+}
+
+// indexName returns the name of the index. Unnamed indexes are named after
+// the table and fields.
+func indexName(idx *dms.Index) string {
+	if idx.Name != "" {
+		return idx.Name
+	}
+
+	return strings.ToLower(fmt.Sprintf("%s_%s_idx", idx.Table, strings.Join(idx.Fields, "_")))
+}
+
+// createIndex returns the CREATE INDEX statement for the index.
+func createIndex(d Dialect, idx *dms.Index) string {
+	cols := make([]string, len(idx.Fields))
+
+	for i, f := range idx.Fields {
+		cols[i] = d.Quote(f)
+
+		switch strings.ToLower(idx.Order) {
+		case "asc":
+			cols[i] += " ASC"
+		case "desc":
+			cols[i] += " DESC"
+		}
+	}
+
+	kind := "INDEX"
+
+	if idx.Unique {
+		kind = "UNIQUE INDEX"
+	}
+
+	return fmt.Sprintf("CREATE %s %s ON %s (%s);",
+		kind,
+		d.Quote(shortenIdent(d, indexName(idx))),
+		d.Quote(idx.Table),
+		strings.Join(cols, ", "))
+}
+
+// writeIndexes writes the CREATE INDEX statements for the table.
+func writeIndexes(w io.Writer, d Dialect, ts *tableSchema) {
+	for _, idx := range ts.Indexes {
+		fmt.Fprintln(w, createIndex(d, idx))
+	}
+}
+
+// schemaForTable returns the schema of the table or an empty schema
+// if none is defined.
+func schemaForTable(schema map[string]*tableSchema, t string) *tableSchema {
+	if ts, ok := schema[strings.ToLower(t)]; ok {
+		return ts
+	}
+
+	return &tableSchema{}
+}
+
+// WriteModelDDL writes a script in the SQL dialect which creates the tables,
+// constraints and indexes of the model. Tables are created in foreign key
+// dependency order followed by the foreign keys and indexes.
+func WriteModelDDL(w io.Writer, m *dms.Model, d Dialect) {
+	schema := indexSchema(m.Schema)
+	tables := sortTablesByDependency(m, schema)
+
+	fmt.Fprintf(w, "-- %s (%s)\n", m, m.URLPath())
+	fmt.Fprintf(w, "-- Dialect: %s\n", d.Name())
+	fmt.Fprintf(w, "-- Generated by %s %s\n", serviceName, progVersion)
+
+	for _, t := range tables {
+		fmt.Fprintln(w)
+		writeCreateTable(w, d, t, schemaForTable(schema, t.Name))
+	}
+
+	if !d.InlineForeignKeys() {
+		for _, t := range tables {
+			if ts := schemaForTable(schema, t.Name); len(ts.ForeignKeys) > 0 {
+				fmt.Fprintln(w)
+				writeForeignKeys(w, d, t, ts)
+			}
+		}
+	}
+
+	for _, t := range tables {
+		if ts := schemaForTable(schema, t.Name); len(ts.Indexes) > 0 {
+			fmt.Fprintln(w)
+			writeIndexes(w, d, ts)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+func TestKeyField(t *testing.T) {
+	ts := &tableSchema{
+		Keys: map[string]bool{"code": true},
+	}
+
+	tests := []struct {
+		dialect string
+		typ     string
+		length  int
+		key     bool
+		column  string
+	}{
+		{"mysql", "varchar", 0, true, "varchar(255)"},
+		{"mysql", "text", 0, true, "varchar(255)"},
+		{"mysql", "varchar", 100, true, "varchar(100)"},
+		{"mysql", "varchar", 768, true, "varchar(768)"},
+
+		// Over the 3072 byte key limit.
+		{"mysql", "varchar", 1000, true, "varchar(768)"},
+
+		// A longtext otherwise.
+		{"mysql", "varchar", 20000, true, "varchar(768)"},
+		{"mysql", "varchar", 20000, false, "longtext"},
+
+		// A varchar(max) otherwise.
+		{"mssql", "varchar", 10000, true, "varchar(900)"},
+		{"mssql", "varchar", 10000, false, "varchar(max)"},
+		{"mssql", "varchar", 0, true, "varchar(255)"},
+
+		{"oracle", "varchar", 5000, true, "varchar2(4000)"},
+		{"oracle", "varchar", 0, true, "varchar2(255)"},
+
+		{"postgresql", "varchar", 0, true, "text"},
+		{"postgresql", "varchar", 20000, true, "varchar(20000)"},
+	}
+
+	for _, test := range tests {
+		d := GetDialect(test.dialect)
+
+		name := "code"
+
+		if !test.key {
+			name = "notes"
+		}
+
+		f := keyField(d, &dms.Field{Name: name, Type: test.typ, Length: test.length}, ts)
+
+		if column := d.Type(f); column != test.column {
+			t.Errorf("%s %s(%d): expected %s, got %s", test.dialect, test.typ, test.length, test.column, column)
+		}
+	}
+}
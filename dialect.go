@@ -0,0 +1,680 @@
+package main
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+const defaultDialect = "postgresql"
+
+// Dialect describes the SQL syntax of a database system which is used to
+// generate DDL for a model.
+type Dialect interface {
+	// Name returns the name the dialect is registered under.
+	Name() string
+
+	// Quote quotes an identifier.
+	Quote(ident string) string
+
+	// Literal quotes a value as a string literal.
+	Literal(value string) string
+
+	// Type returns the column type of a field based on its generic type.
+	Type(f *dms.Field) string
+
+	// MaxIdentLength returns the maximum length of an identifier or zero
+	// if there is no limit.
+	MaxIdentLength() int
+
+	// MaxKeyLength returns the maximum length of the string columns of keys
+	// and indexes, or zero if strings of any length can be indexed.
+	MaxKeyLength() int
+
+	// InlineForeignKeys returns true if foreign keys must be declared in
+	// the CREATE TABLE statement rather than added by an ALTER TABLE. These
+	// dialects cannot add or drop constraints of existing tables.
+	InlineForeignKeys() bool
+
+	// AddColumn returns the statement adding the column definition to
+	// the table.
+	AddColumn(table, def string) string
+
+	// AlterColumnType returns the statements changing the type of a column
+	// or nil if the dialect cannot alter columns. Dialects redefining the
+	// whole column return the same statements for each change of it.
+	AlterColumnType(table string, f *dms.Field, notNull bool) []string
+
+	// AlterColumnNull returns the statements changing whether a column is
+	// nullable or nil if the dialect cannot alter columns.
+	AlterColumnNull(table string, f *dms.Field, notNull bool) []string
+
+	// AlterColumnDefault returns the statements setting the default of a
+	// column to the default of the field, or dropping it if the field has
+	// none, or nil if the dialect cannot change defaults.
+	AlterColumnDefault(table string, f *dms.Field, notNull bool) []string
+
+	// DropConstraint returns the statement dropping a named constraint. The
+	// kind is one of PRIMARY KEY, UNIQUE or FOREIGN KEY.
+	DropConstraint(table, name, kind string) string
+
+	// DropIndex returns the statement dropping an index of the table.
+	DropIndex(table, name string) string
+
+	// RenameTable returns the statement renaming a table.
+	RenameTable(from, to string) string
+
+	// RenameColumn returns the statement renaming a column of the table.
+	RenameColumn(table, from, to string) string
+}
+
+// Registry of dialects by name and alias.
+var dialects = make(map[string]Dialect)
+
+// RegisterDialect registers a dialect under its name and any aliases.
+func RegisterDialect(d Dialect, aliases ...string) {
+	dialects[strings.ToLower(d.Name())] = d
+
+	for _, a := range aliases {
+		dialects[strings.ToLower(a)] = d
+	}
+}
+
+// GetDialect returns the dialect registered under the name or alias or
+// nil if no dialect is registered.
+func GetDialect(name string) Dialect {
+	return dialects[strings.ToLower(name)]
+}
+
+// DialectNames returns a sorted list of the names of the registered dialects.
+func DialectNames() []string {
+	var names []string
+
+	for k, d := range dialects {
+		if k == strings.ToLower(d.Name()) {
+			names = append(names, d.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func init() {
+	RegisterDialect(postgresDialect{}, "postgres", "pg")
+	RegisterDialect(mysqlDialect{})
+	RegisterDialect(sqliteDialect{}, "sqlite3")
+	RegisterDialect(oracleDialect{})
+	RegisterDialect(mssqlDialect{}, "sqlserver")
+}
+
+// Generic types declared in the schema files.
+const (
+	stringType       = "string"
+	textType         = "text"
+	integerType      = "integer"
+	bigIntegerType   = "biginteger"
+	smallIntegerType = "smallinteger"
+	decimalType      = "decimal"
+	floatType        = "float"
+	doubleType       = "double"
+	booleanType      = "boolean"
+	dateType         = "date"
+	timeType         = "time"
+	datetimeType     = "datetime"
+)
+
+// genericTypes maps the type names used in the wild to a generic type.
+var genericTypes = map[string]string{
+	"":             textType,
+	"string":       stringType,
+	"varchar":      stringType,
+	"char":         stringType,
+	"text":         textType,
+	"clob":         textType,
+	"integer":      integerType,
+	"int":          integerType,
+	"biginteger":   bigIntegerType,
+	"bigint":       bigIntegerType,
+	"smallinteger": smallIntegerType,
+	"smallint":     smallIntegerType,
+	"decimal":      decimalType,
+	"numeric":      decimalType,
+	"number":       decimalType,
+	"float":        floatType,
+	"real":         floatType,
+	"double":       doubleType,
+	"boolean":      booleanType,
+	"bool":         booleanType,
+	"date":         dateType,
+	"time":         timeType,
+	"datetime":     datetimeType,
+	"timestamp":    datetimeType,
+}
+
+// genericType returns the generic type of the field or an empty string
+// if the type is not known.
+func genericType(f *dms.Field) string {
+	return genericTypes[strings.ToLower(f.Type)]
+}
+
+// precisionScale formats the type name with the precision and scale of
+// the field if defined.
+func precisionScale(name string, f *dms.Field) string {
+	if f.Precision > 0 && f.Scale > 0 {
+		return fmt.Sprintf("%s(%d, %d)", name, f.Precision, f.Scale)
+	} else if f.Precision > 0 {
+		return fmt.Sprintf("%s(%d)", name, f.Precision)
+	}
+
+	return name
+}
+
+// quoteWith quotes the identifier with the delimiters, escaping the closing
+// delimiter by doubling it.
+func quoteWith(ident, open, close string) string {
+	return open + strings.Replace(ident, close, close+close, -1) + close
+}
+
+// shortenIdent shortens an identifier to the max length of the dialect. The
+// end of the identifier is replaced with a hash of the full name so shortened
+// names remain unique.
+func shortenIdent(d Dialect, ident string) string {
+	max := d.MaxIdentLength()
+
+	if max == 0 || len(ident) <= max {
+		return ident
+	}
+
+	h := fmt.Sprintf("%x", sha1.Sum([]byte(ident)))[:8]
+
+	return ident[:max-len(h)-1] + "_" + h
+}
+
+func nullClause(notNull bool) string {
+	if notNull {
+		return "NOT NULL"
+	}
+
+	return "NULL"
+}
+
+// sqlString escapes a value for use in a string literal.
+func sqlString(s string) string {
+	return strings.Replace(s, "'", "''", -1)
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string            { return "postgresql" }
+func (postgresDialect) MaxIdentLength() int     { return 63 }
+func (postgresDialect) MaxKeyLength() int       { return 0 }
+func (postgresDialect) InlineForeignKeys() bool { return false }
+
+func (postgresDialect) Literal(s string) string {
+	return "'" + sqlString(s) + "'"
+}
+
+func (postgresDialect) Quote(s string) string {
+	return quoteWith(s, `"`, `"`)
+}
+
+func (postgresDialect) Type(f *dms.Field) string {
+	switch genericType(f) {
+	case stringType:
+		if f.Length > 0 {
+			return fmt.Sprintf("varchar(%d)", f.Length)
+		}
+
+		return "text"
+	case textType:
+		return "text"
+	case integerType:
+		return "integer"
+	case bigIntegerType:
+		return "bigint"
+	case smallIntegerType:
+		return "smallint"
+	case decimalType:
+		return precisionScale("numeric", f)
+	case floatType:
+		return "real"
+	case doubleType:
+		return "double precision"
+	case booleanType:
+		return "boolean"
+	case dateType:
+		return "date"
+	case timeType:
+		return "time"
+	case datetimeType:
+		return "timestamp"
+	}
+
+	return f.Type
+}
+
+func (d postgresDialect) AddColumn(table, def string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", d.Quote(table), def)
+}
+
+func (d postgresDialect) AlterColumnType(table string, f *dms.Field, notNull bool) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s;", d.Quote(table), d.Quote(f.Name), d.Type(f)),
+	}
+}
+
+func (d postgresDialect) AlterColumnNull(table string, f *dms.Field, notNull bool) []string {
+	op := "DROP"
+
+	if notNull {
+		op = "SET"
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s NOT NULL;", d.Quote(table), d.Quote(f.Name), op),
+	}
+}
+
+func (d postgresDialect) AlterColumnDefault(table string, f *dms.Field, notNull bool) []string {
+	if f.Default == "" {
+		return []string{
+			fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;", d.Quote(table), d.Quote(f.Name)),
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;", d.Quote(table), d.Quote(f.Name), defaultValue(d, f.Default)),
+	}
+}
+
+func (d postgresDialect) DropConstraint(table, name, kind string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", d.Quote(table), d.Quote(name))
+}
+
+func (d postgresDialect) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX %s;", d.Quote(name))
+}
+
+func (d postgresDialect) RenameTable(from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", d.Quote(from), d.Quote(to))
+}
+
+func (d postgresDialect) RenameColumn(table, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s;", d.Quote(table), d.Quote(from), d.Quote(to))
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string            { return "mysql" }
+func (mysqlDialect) MaxIdentLength() int     { return 64 }
+func (mysqlDialect) MaxKeyLength() int       { return 768 } // 3072 bytes of utf8mb4
+func (mysqlDialect) InlineForeignKeys() bool { return false }
+
+// Backslashes are escape characters in MySQL strings.
+func (mysqlDialect) Literal(s string) string {
+	return "'" + sqlString(strings.Replace(s, `\`, `\\`, -1)) + "'"
+}
+
+func (mysqlDialect) Quote(s string) string {
+	return quoteWith(s, "`", "`")
+}
+
+func (mysqlDialect) Type(f *dms.Field) string {
+	switch genericType(f) {
+	case stringType:
+		// Longer strings may exceed the row size limit.
+		if f.Length > 0 && f.Length <= 16383 {
+			return fmt.Sprintf("varchar(%d)", f.Length)
+		}
+
+		return "longtext"
+	case textType:
+		return "longtext"
+	case integerType:
+		return "int"
+	case bigIntegerType:
+		return "bigint"
+	case smallIntegerType:
+		return "smallint"
+	case decimalType:
+		return precisionScale("decimal", f)
+	case floatType:
+		return "float"
+	case doubleType:
+		return "double"
+	case booleanType:
+		return "boolean"
+	case dateType:
+		return "date"
+	case timeType:
+		return "time"
+	case datetimeType:
+		return "datetime"
+	}
+
+	return f.Type
+}
+
+func (d mysqlDialect) AddColumn(table, def string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", d.Quote(table), def)
+}
+
+// MySQL redefines the whole column so the nullability and the default must
+// be included, a column modified without a default loses it.
+func (d mysqlDialect) AlterColumnType(table string, f *dms.Field, notNull bool) []string {
+	def := fmt.Sprintf("%s %s", d.Quote(f.Name), d.Type(f))
+
+	if f.Default != "" {
+		def += " DEFAULT " + defaultValue(d, f.Default)
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s %s;", d.Quote(table), def, nullClause(notNull)),
+	}
+}
+
+func (d mysqlDialect) AlterColumnNull(table string, f *dms.Field, notNull bool) []string {
+	return d.AlterColumnType(table, f, notNull)
+}
+
+func (d mysqlDialect) AlterColumnDefault(table string, f *dms.Field, notNull bool) []string {
+	return d.AlterColumnType(table, f, notNull)
+}
+
+func (d mysqlDialect) DropConstraint(table, name, kind string) string {
+	switch kind {
+	case "PRIMARY KEY":
+		return fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY;", d.Quote(table))
+	case "UNIQUE":
+		return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s;", d.Quote(table), d.Quote(name))
+	case "FOREIGN KEY":
+		return fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s;", d.Quote(table), d.Quote(name))
+	}
+
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", d.Quote(table), d.Quote(name))
+}
+
+func (d mysqlDialect) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX %s ON %s;", d.Quote(name), d.Quote(table))
+}
+
+func (d mysqlDialect) RenameTable(from, to string) string {
+	return fmt.Sprintf("RENAME TABLE %s TO %s;", d.Quote(from), d.Quote(to))
+}
+
+// Requires MySQL 8.0, earlier versions must use CHANGE COLUMN with the
+// full column definition.
+func (d mysqlDialect) RenameColumn(table, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s;", d.Quote(table), d.Quote(from), d.Quote(to))
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string            { return "sqlite" }
+func (sqliteDialect) MaxIdentLength() int     { return 0 }
+func (sqliteDialect) MaxKeyLength() int       { return 0 }
+func (sqliteDialect) InlineForeignKeys() bool { return true }
+
+func (sqliteDialect) Literal(s string) string {
+	return "'" + sqlString(s) + "'"
+}
+
+func (sqliteDialect) Quote(s string) string {
+	return quoteWith(s, `"`, `"`)
+}
+
+// SQLite only has a few storage classes, but declaring the types keeps
+// the script readable.
+func (sqliteDialect) Type(f *dms.Field) string {
+	switch genericType(f) {
+	case stringType:
+		if f.Length > 0 {
+			return fmt.Sprintf("varchar(%d)", f.Length)
+		}
+
+		return "text"
+	case textType:
+		return "text"
+	case integerType, bigIntegerType, smallIntegerType:
+		return "integer"
+	case decimalType:
+		return precisionScale("numeric", f)
+	case floatType, doubleType:
+		return "real"
+	case booleanType:
+		return "boolean"
+	case dateType:
+		return "date"
+	case timeType:
+		return "time"
+	case datetimeType:
+		return "datetime"
+	}
+
+	return f.Type
+}
+
+func (d sqliteDialect) AddColumn(table, def string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", d.Quote(table), def)
+}
+
+// SQLite cannot alter columns, the table must be rebuilt.
+func (sqliteDialect) AlterColumnType(table string, f *dms.Field, notNull bool) []string {
+	return nil
+}
+
+func (sqliteDialect) AlterColumnNull(table string, f *dms.Field, notNull bool) []string {
+	return nil
+}
+
+func (sqliteDialect) AlterColumnDefault(table string, f *dms.Field, notNull bool) []string {
+	return nil
+}
+
+func (d sqliteDialect) DropConstraint(table, name, kind string) string {
+	return ""
+}
+
+func (d sqliteDialect) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX %s;", d.Quote(name))
+}
+
+func (d sqliteDialect) RenameTable(from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", d.Quote(from), d.Quote(to))
+}
+
+// Requires SQLite 3.25.
+func (d sqliteDialect) RenameColumn(table, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s;", d.Quote(table), d.Quote(from), d.Quote(to))
+}
+
+type oracleDialect struct{}
+
+func (oracleDialect) Name() string            { return "oracle" }
+func (oracleDialect) MaxIdentLength() int     { return 30 }
+func (oracleDialect) MaxKeyLength() int       { return 4000 } // Longer strings are clobs.
+func (oracleDialect) InlineForeignKeys() bool { return false }
+
+func (oracleDialect) Literal(s string) string {
+	return "'" + sqlString(s) + "'"
+}
+
+// Quoted identifiers are case sensitive in Oracle. Upper case matches
+// the unquoted form so the objects can be referenced without quotes.
+func (oracleDialect) Quote(s string) string {
+	return quoteWith(strings.ToUpper(s), `"`, `"`)
+}
+
+func (oracleDialect) Type(f *dms.Field) string {
+	switch genericType(f) {
+	case stringType:
+		if f.Length > 0 && f.Length <= 4000 {
+			return fmt.Sprintf("varchar2(%d)", f.Length)
+		}
+
+		return "clob"
+	case textType:
+		return "clob"
+	case integerType:
+		return "number(10)"
+	case bigIntegerType:
+		return "number(19)"
+	case smallIntegerType:
+		return "number(5)"
+	case decimalType:
+		return precisionScale("number", f)
+	case floatType:
+		return "binary_float"
+	case doubleType:
+		return "binary_double"
+	case booleanType:
+		return "number(1)"
+	case dateType:
+		return "date"
+	case timeType, datetimeType:
+		return "timestamp"
+	}
+
+	return f.Type
+}
+
+func (d oracleDialect) AddColumn(table, def string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD (%s);", d.Quote(table), def)
+}
+
+// Oracle raises an error if the nullability is set to its current value so
+// it is only changed by AlterColumnNull.
+func (d oracleDialect) AlterColumnType(table string, f *dms.Field, notNull bool) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s MODIFY (%s %s);", d.Quote(table), d.Quote(f.Name), d.Type(f)),
+	}
+}
+
+func (d oracleDialect) AlterColumnNull(table string, f *dms.Field, notNull bool) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s MODIFY (%s %s);", d.Quote(table), d.Quote(f.Name), nullClause(notNull)),
+	}
+}
+
+// A default of null drops the default.
+func (d oracleDialect) AlterColumnDefault(table string, f *dms.Field, notNull bool) []string {
+	v := "NULL"
+
+	if f.Default != "" {
+		v = defaultValue(d, f.Default)
+	}
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s MODIFY (%s DEFAULT %s);", d.Quote(table), d.Quote(f.Name), v),
+	}
+}
+
+func (d oracleDialect) DropConstraint(table, name, kind string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", d.Quote(table), d.Quote(name))
+}
+
+func (d oracleDialect) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX %s;", d.Quote(name))
+}
+
+func (d oracleDialect) RenameTable(from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", d.Quote(from), d.Quote(to))
+}
+
+func (d oracleDialect) RenameColumn(table, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s;", d.Quote(table), d.Quote(from), d.Quote(to))
+}
+
+type mssqlDialect struct{}
+
+func (mssqlDialect) Name() string            { return "mssql" }
+func (mssqlDialect) MaxIdentLength() int     { return 128 }
+func (mssqlDialect) MaxKeyLength() int       { return 900 } // 900 bytes of a clustered index
+func (mssqlDialect) InlineForeignKeys() bool { return false }
+
+func (mssqlDialect) Literal(s string) string {
+	return "'" + sqlString(s) + "'"
+}
+
+func (mssqlDialect) Quote(s string) string {
+	return quoteWith(s, "[", "]")
+}
+
+func (mssqlDialect) Type(f *dms.Field) string {
+	switch genericType(f) {
+	case stringType:
+		if f.Length > 0 && f.Length <= 8000 {
+			return fmt.Sprintf("varchar(%d)", f.Length)
+		}
+
+		return "varchar(max)"
+	case textType:
+		return "varchar(max)"
+	case integerType:
+		return "int"
+	case bigIntegerType:
+		return "bigint"
+	case smallIntegerType:
+		return "smallint"
+	case decimalType:
+		return precisionScale("decimal", f)
+	case floatType:
+		return "real"
+	case doubleType:
+		return "float"
+	case booleanType:
+		return "bit"
+	case dateType:
+		return "date"
+	case timeType:
+		return "time"
+	case datetimeType:
+		return "datetime2"
+	}
+
+	return f.Type
+}
+
+func (d mssqlDialect) AddColumn(table, def string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD %s;", d.Quote(table), def)
+}
+
+// SQL Server redefines the column type and nullability together. The
+// default is a separate constraint which is kept.
+func (d mssqlDialect) AlterColumnType(table string, f *dms.Field, notNull bool) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s %s;", d.Quote(table), d.Quote(f.Name), d.Type(f), nullClause(notNull)),
+	}
+}
+
+func (d mssqlDialect) AlterColumnNull(table string, f *dms.Field, notNull bool) []string {
+	return d.AlterColumnType(table, f, notNull)
+}
+
+// Defaults declared with the column are constraints named by the server,
+// which cannot be dropped by name.
+func (mssqlDialect) AlterColumnDefault(table string, f *dms.Field, notNull bool) []string {
+	return nil
+}
+
+func (d mssqlDialect) DropConstraint(table, name, kind string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", d.Quote(table), d.Quote(name))
+}
+
+func (d mssqlDialect) DropIndex(table, name string) string {
+	return fmt.Sprintf("DROP INDEX %s ON %s;", d.Quote(name), d.Quote(table))
+}
+
+// sp_rename takes the new name unquoted.
+func (d mssqlDialect) RenameTable(from, to string) string {
+	return fmt.Sprintf("EXEC sp_rename '%s', '%s';", sqlString(d.Quote(from)), sqlString(to))
+}
+
+func (d mssqlDialect) RenameColumn(table, from, to string) string {
+	return fmt.Sprintf("EXEC sp_rename '%s.%s', '%s', 'COLUMN';", sqlString(d.Quote(table)), sqlString(d.Quote(from)), sqlString(to))
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"regexp"
@@ -26,7 +27,8 @@ func diffKeys(attrs dms.Attrs) []string {
 
 	for k, _ := range attrs {
 		switch k {
-		case "model", "version":
+		// The names are compared by the table and field diffs.
+		case "model", "version", "table", "field":
 			continue
 		default:
 			keys = append(keys, k)
@@ -40,14 +42,15 @@ func diffKeys(attrs dms.Attrs) []string {
 
 // Stats holds counts of a Diff.
 type Stats struct {
-	Added   int
-	Removed int
-	Changes int
-	Matches int
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+	Renamed int `json:"renamed"`
+	Changes int `json:"changed"`
+	Matches int `json:"matched"`
 }
 
 func (s *Stats) Total() int {
-	return s.Added + s.Removed + s.Changes + s.Matches
+	return s.Added + s.Removed + s.Renamed + s.Changes + s.Matches
 }
 
 func (s *Stats) Write(w io.Writer, f int) {
@@ -59,6 +62,11 @@ func (s *Stats) Write(w io.Writer, f int) {
 		fmt.Fprintf(w, "%d removed, ", s.Removed)
 	}
 
+	// Only shown if there are renames since most things cannot be renamed.
+	if f&Fdiff > 0 && s.Renamed > 0 {
+		fmt.Fprintf(w, "%d renamed, ", s.Renamed)
+	}
+
 	if f&Fchanges > 0 {
 		fmt.Fprintf(w, "%d changed, ", s.Changes)
 	}
@@ -68,12 +76,56 @@ func (s *Stats) Write(w io.Writer, f int) {
 	}
 }
 
+// Change holds the values of an attribute before and after a change.
+type Change struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
 // Diff holds information about the differences between two things.
 type Diff struct {
-	Added   []string
-	Removed []string
-	Matches []string
-	Changes map[string][2]string
+	Added   []string           `json:"added"`
+	Removed []string           `json:"removed"`
+	Matches []string           `json:"matched"`
+	Changes map[string]*Change `json:"changed,omitempty"`
+
+	// New names keyed by the old name.
+	Renamed map[string]string `json:"renamed,omitempty"`
+}
+
+// HasChanges returns true if anything was added, removed, renamed or changed.
+func (d *Diff) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Renamed) > 0 || len(d.Changes) > 0
+}
+
+// Rename records that a removed key was renamed to an added key.
+func (d *Diff) Rename(from, to string) {
+	d.Removed = removeString(d.Removed, from)
+	d.Added = removeString(d.Added, to)
+
+	if d.Renamed == nil {
+		d.Renamed = make(map[string]string)
+	}
+
+	d.Renamed[from] = to
+}
+
+// RenamedKeys returns a sorted list of the old names of the renamed keys.
+func (d *Diff) RenamedKeys() []string {
+	return sortedKeys(d.Renamed)
+}
+
+// ChangedKeys returns a sorted list of the keys that changed.
+func (d *Diff) ChangedKeys() []string {
+	keys := make([]string, 0, len(d.Changes))
+
+	for k := range d.Changes {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
 }
 
 // Stats returns stats for this diff.
@@ -88,6 +140,10 @@ func (d *Diff) Stats() *Stats {
 		s.Removed = len(d.Removed)
 	}
 
+	if d.Renamed != nil {
+		s.Renamed = len(d.Renamed)
+	}
+
 	if d.Changes != nil {
 		s.Changes = len(d.Changes)
 	}
@@ -118,13 +174,30 @@ func (d *Diff) Write(w io.Writer, f int) {
 		}
 	}
 
-	if d.Matches != nil && f&Fchanges > 0 {
-		for k, v := range d.Changes {
-			fmt.Fprintf(w, "~ %s\n    - %s\n    + %s\n", k, v[0], v[1])
+	if d.Renamed != nil && f&Fdiff > 0 {
+		for _, k := range d.RenamedKeys() {
+			fmt.Fprintf(w, "> %s -> %s\n", k, d.Renamed[k])
+		}
+	}
+
+	if d.Changes != nil && f&Fchanges > 0 {
+		for _, k := range d.ChangedKeys() {
+			v := d.Changes[k]
+			fmt.Fprintf(w, "~ %s\n    - %s\n    + %s\n", k, v.Before, v.After)
 		}
 	}
 }
 
+func removeString(l []string, s string) []string {
+	for i, x := range l {
+		if x == s {
+			return append(l[:i], l[i+1:]...)
+		}
+	}
+
+	return l
+}
+
 // DiffStrings compares two sorted slices of strings and returns the diff.
 func DiffStrings(akeys, bkeys []string) *Diff {
 	var (
@@ -209,7 +282,7 @@ func DiffAttrs(adoc, bdoc dms.Attrs) *Diff {
 	added := make([]string, 0)
 	removed := make([]string, 0)
 	matches := make([]string, 0)
-	changes := make(map[string][2]string)
+	changes := make(map[string]*Change)
 
 	akeys := diffKeys(adoc)
 	bkeys := diffKeys(bdoc)
@@ -249,7 +322,10 @@ func DiffAttrs(adoc, bdoc dms.Attrs) *Diff {
 			if av == bv {
 				matches = append(matches, ak)
 			} else {
-				changes[ak] = [2]string{adoc[ak], bdoc[bk]}
+				changes[ak] = &Change{
+					Before: adoc[ak],
+					After:  bdoc[bk],
+				}
 			}
 
 			ai++
@@ -274,9 +350,439 @@ func DiffAttrs(adoc, bdoc dms.Attrs) *Diff {
 	}
 }
 
-func DiffModels(out io.Writer, a, b *dms.Model) {
-	buff := bytes.NewBuffer(nil)
+// SchemaDiff holds the differences between the constraints or indexes of two
+// models. The definition of each key is included since the keys alone do not
+// describe what was added or removed.
+type SchemaDiff struct {
+	*Diff
+
+	Definitions map[string]string `json:"definitions"`
+}
+
+func (d *SchemaDiff) Write(w io.Writer, f int) {
+	if d.Matches != nil && f&Fmatches > 0 {
+		for _, k := range d.Matches {
+			fmt.Fprintf(w, "  %s: %s\n", k, d.Definitions[k])
+		}
+	}
+
+	if d.Added != nil && f&Fadded > 0 {
+		for _, k := range d.Added {
+			fmt.Fprintf(w, "+ %s: %s\n", k, d.Definitions[k])
+		}
+	}
+
+	if d.Removed != nil && f&Fremoved > 0 {
+		for _, k := range d.Removed {
+			fmt.Fprintf(w, "- %s: %s\n", k, d.Definitions[k])
+		}
+	}
+
+	if d.Changes != nil && f&Fchanges > 0 {
+		for _, k := range d.ChangedKeys() {
+			v := d.Changes[k]
+			fmt.Fprintf(w, "~ %s\n    - %s\n    + %s\n", k, v.Before, v.After)
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// DiffDefinitions compares two sets of definitions by key.
+func DiffDefinitions(a, b map[string]string) *SchemaDiff {
+	diff := DiffStrings(sortedKeys(a), sortedKeys(b))
+
+	defs := make(map[string]string)
+	matches := make([]string, 0)
+	diff.Changes = make(map[string]*Change)
+
+	for _, k := range diff.Removed {
+		defs[k] = a[k]
+	}
+
+	for _, k := range diff.Added {
+		defs[k] = b[k]
+	}
+
+	for _, k := range diff.Matches {
+		defs[k] = b[k]
+
+		if a[k] == b[k] {
+			matches = append(matches, k)
+		} else {
+			diff.Changes[k] = &Change{
+				Before: a[k],
+				After:  b[k],
+			}
+		}
+	}
+
+	diff.Matches = matches
+
+	return &SchemaDiff{
+		Diff:        diff,
+		Definitions: defs,
+	}
+}
+
+// constraint is a constraint of a model. Composite constraints are declared
+// as multiple records sharing a name.
+type constraint struct {
+	Kind         string
+	Name         string
+	Table        string
+	Fields       []string
+	TargetTable  string
+	TargetFields []string
+}
+
+// Key returns the name of the constraint or, for unnamed constraints, a key
+// derived from the kind, table and fields.
+func (c *constraint) Key() string {
+	if c.Name != "" {
+		return c.Name
+	}
+
+	return fmt.Sprintf("%s %s (%s)", strings.ToLower(c.Kind), c.Table, strings.Join(c.Fields, ", "))
+}
+
+func (c *constraint) String() string {
+	def := fmt.Sprintf("%s %s (%s)", c.Kind, c.Table, strings.Join(c.Fields, ", "))
+
+	if c.Kind == "FOREIGN KEY" {
+		def += fmt.Sprintf(" REFERENCES %s (%s)", c.TargetTable, strings.Join(c.TargetFields, ", "))
+	}
+
+	return def
+}
+
+// modelConstraints returns the constraints of the schema by key.
+func modelConstraints(s *dms.Schema) map[string]*constraint {
+	cs := make(map[string]*constraint)
+
+	add := func(c *constraint) {
+		cs[c.Key()] = c
+	}
+
+	for _, ts := range indexSchema(s) {
+		if pk := ts.PrimaryKey; pk != nil {
+			add(&constraint{
+				Kind:   "PRIMARY KEY",
+				Name:   pk.Name,
+				Table:  pk.Table,
+				Fields: pk.Fields,
+			})
+		}
+
+		for _, un := range ts.Uniques {
+			add(&constraint{
+				Kind:   "UNIQUE",
+				Name:   un.Name,
+				Table:  un.Table,
+				Fields: un.Fields,
+			})
+		}
+
+		for _, fk := range groupForeignKeys(ts.ForeignKeys) {
+			add(&constraint{
+				Kind:         "FOREIGN KEY",
+				Name:         fk.Name,
+				Table:        fk.Table,
+				Fields:       fk.Fields,
+				TargetTable:  fk.TargetTable,
+				TargetFields: fk.TargetFields,
+			})
+		}
+	}
+
+	if s != nil {
+		for _, nn := range s.NotNullables {
+			add(&constraint{
+				Kind:   "NOT NULL",
+				Table:  nn.Table,
+				Fields: []string{nn.Field},
+			})
+		}
+	}
+
+	return cs
+}
+
+func constraintDefinitions(cs map[string]*constraint) map[string]string {
+	defs := make(map[string]string, len(cs))
+
+	for k, c := range cs {
+		defs[k] = c.String()
+	}
+
+	return defs
+}
+
+// indexKey returns the signature of an index which is the table and fields.
+func indexKey(idx *dms.Index) string {
+	return fmt.Sprintf("%s (%s)", idx.Table, strings.Join(idx.Fields, ", "))
+}
+
+// indexDefinition describes the properties of an index other than the
+// fields it is keyed by.
+func indexDefinition(idx *dms.Index) string {
+	def := idx.Name
+
+	if idx.Unique {
+		def += " unique"
+	}
+
+	if idx.Order != "" {
+		def += " " + strings.ToLower(idx.Order)
+	}
+
+	return def
+}
+
+// modelIndexes returns the indexes of the schema by signature.
+func modelIndexes(s *dms.Schema) map[string]*dms.Index {
+	idxs := make(map[string]*dms.Index)
+
+	if s == nil {
+		return idxs
+	}
+
+	for _, idx := range s.Indexes {
+		idxs[indexKey(idx)] = idx
+	}
 
+	return idxs
+}
+
+func indexDefinitions(idxs map[string]*dms.Index) map[string]string {
+	defs := make(map[string]string, len(idxs))
+
+	for k, idx := range idxs {
+		defs[k] = indexDefinition(idx)
+	}
+
+	return defs
+}
+
+// FieldDiff holds the attribute differences of a field present in both
+// tables, possibly under a different name.
+type FieldDiff struct {
+	Field       string `json:"field"`
+	RenamedFrom string `json:"renamed_from,omitempty"`
+	Attrs       *Diff  `json:"attrs"`
+	Stats       *Stats `json:"stats"`
+}
+
+// TableDiff holds the field differences of a table present in both models,
+// possibly under a different name.
+type TableDiff struct {
+	Table       string       `json:"table"`
+	RenamedFrom string       `json:"renamed_from,omitempty"`
+	Fields      *Diff        `json:"fields"`
+	Stats       *Stats       `json:"stats"`
+	Changes     []*FieldDiff `json:"changes"`
+
+	// Fields that look like they were renamed. These are also reported as
+	// renames if the suggestions are applied.
+	Suggestions []*RenameSuggestion `json:"suggested_renames,omitempty"`
+}
+
+// renamedTable follows the renames of a table back to a table of the model.
+func renamedTable(t *dms.Table, m *dms.Model) *dms.Table {
+	seen := make(map[*dms.Table]bool)
+
+	for p := t.RenamedFrom; p != nil && !seen[p]; p = p.RenamedFrom {
+		if p.Model == m {
+			return p
+		}
+
+		seen[p] = true
+	}
+
+	return nil
+}
+
+// renamedField follows the renames of a field back to a field of the table.
+func renamedField(f *dms.Field, t *dms.Table) *dms.Field {
+	seen := make(map[*dms.Field]bool)
+
+	for p := f.RenamedFrom; p != nil && !seen[p]; p = p.RenamedFrom {
+		if p.Table == t {
+			return p
+		}
+
+		seen[p] = true
+	}
+
+	return nil
+}
+
+// ModelDiff is the result of comparing two models. The tables and fields of
+// the models are compared by name, or by the renames declared between the
+// versions, and the attributes of the matched fields are compared.
+type ModelDiff struct {
+	From *dms.Model
+	To   *dms.Model
+
+	Tables     *Diff
+	TableStats *Stats
+	FieldStats *Stats
+
+	// Field differences of the matched and renamed tables.
+	Fields []*TableDiff
+
+	// Constraints keyed by name and indexes keyed by table and fields.
+	Constraints *SchemaDiff
+	Indexes     *SchemaDiff
+
+	// Classification of the differences.
+	Compatibility *Compatibility
+}
+
+// Table returns the field differences of a matched or renamed table by
+// its new name.
+func (d *ModelDiff) Table(name string) *TableDiff {
+	for _, td := range d.Fields {
+		if td.Table == name {
+			return td
+		}
+	}
+
+	return nil
+}
+
+// diffSide identifies a compared model. The commit distinguishes the same
+// model version compared as of two commits.
+func diffSide(m *dms.Model) map[string]string {
+	side := map[string]string{
+		"model":   m.Name,
+		"version": m.QualifiedVersion(),
+	}
+
+	if m.Commit != "" {
+		side["commit"] = m.Commit
+	}
+
+	return side
+}
+
+func (d *ModelDiff) MarshalJSON() ([]byte, error) {
+	aux := map[string]interface{}{
+		"from": diffSide(d.From),
+		"to":   diffSide(d.To),
+		"stats": map[string]*Stats{
+			"tables":      d.TableStats,
+			"fields":      d.FieldStats,
+			"constraints": d.Constraints.Stats(),
+			"indexes":     d.Indexes.Stats(),
+		},
+		"tables":        d.Tables,
+		"fields":        d.Fields,
+		"constraints":   d.Constraints,
+		"indexes":       d.Indexes,
+		"compatibility": d.Compatibility,
+	}
+
+	return json.Marshal(aux)
+}
+
+func containsString(l []string, s string) bool {
+	for _, x := range l {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffTables compares the fields of a pair of tables and adds the counts to
+// the field stats.
+func diffTables(at, bt *dms.Table, fieldStats *Stats, renames RenameMode) *TableDiff {
+	afields := at.Fields
+	bfields := bt.Fields
+
+	td := &TableDiff{
+		Table:   bt.Name,
+		Fields:  DiffStrings(afields.Names(), bfields.Names()),
+		Changes: make([]*FieldDiff, 0),
+	}
+
+	for _, k := range append([]string(nil), td.Fields.Added...) {
+		if af := renamedField(bfields.Get(k), at); af != nil && containsString(td.Fields.Removed, af.Name) {
+			td.Fields.Rename(af.Name, k)
+		}
+	}
+
+	if renames != RenamesOff {
+		td.Suggestions = suggestRenames(at, bt, td.Fields)
+	}
+
+	if renames == RenamesApply {
+		for _, s := range td.Suggestions {
+			td.Fields.Rename(s.From, s.To)
+		}
+	}
+
+	fieldStats.Added += len(td.Fields.Added)
+	fieldStats.Removed += len(td.Fields.Removed)
+	fieldStats.Renamed += len(td.Fields.Renamed)
+
+	var changed int
+
+	// Diff the matched fields.
+	for _, f := range td.Fields.Matches {
+		diff := DiffAttrs(afields.Get(f).Attrs, bfields.Get(f).Attrs)
+
+		if diff.HasChanges() {
+			td.Changes = append(td.Changes, &FieldDiff{
+				Field: f,
+				Attrs: diff,
+				Stats: diff.Stats(),
+			})
+
+			changed++
+		} else {
+			fieldStats.Matches++
+		}
+	}
+
+	fieldStats.Changes += changed
+
+	// Renamed fields are always listed, the rename is the change.
+	for _, f := range td.Fields.RenamedKeys() {
+		diff := DiffAttrs(afields.Get(f).Attrs, bfields.Get(td.Fields.Renamed[f]).Attrs)
+
+		td.Changes = append(td.Changes, &FieldDiff{
+			Field:       td.Fields.Renamed[f],
+			RenamedFrom: f,
+			Attrs:       diff,
+			Stats:       diff.Stats(),
+		})
+	}
+
+	td.Stats = td.Fields.Stats()
+	td.Stats.Changes = changed
+	td.Stats.Matches -= changed
+
+	return td
+}
+
+// DiffModels compares two models. Tables and fields that were renamed
+// between the versions are reported as renames and their attributes are
+// compared as if they were matched. Depending on the rename mode, fields
+// that look like they were renamed are suggested or reported as renames.
+func DiffModels(a, b *dms.Model, renames RenameMode) *ModelDiff {
 	fieldStats := Stats{}
 
 	atables := a.Tables
@@ -284,90 +790,146 @@ func DiffModels(out io.Writer, a, b *dms.Model) {
 
 	tableDiff := DiffStrings(atables.Names(), btables.Names())
 
-	fmt.Fprintln(buff, "# Tables")
-
-	fmt.Fprintln(buff, "\n```")
-	tableDiff.Write(buff, Fdiff)
-	fmt.Fprintln(buff, "```")
+	for _, k := range append([]string(nil), tableDiff.Added...) {
+		if at := renamedTable(btables.Get(k), a); at != nil && containsString(tableDiff.Removed, at.Name) {
+			tableDiff.Rename(at.Name, k)
+		}
+	}
 
 	// Fields of new tables.
 	for _, k := range tableDiff.Added {
-		fieldStats.Added += len(btables.Get(k).Attrs)
+		fieldStats.Added += btables.Get(k).Fields.Len()
 	}
 
 	// Fields of removed tables.
 	for _, k := range tableDiff.Removed {
-		fieldStats.Removed += len(atables.Get(k).Attrs)
+		fieldStats.Removed += atables.Get(k).Fields.Len()
 	}
 
-	// Matches are recursed
-	var (
-		afields, bfields *dms.Fields
-		adoc, bdoc       dms.Attrs
+	// Matched and renamed tables are recursed.
+	var tableDiffs []*TableDiff
+
+	for _, k := range tableDiff.Matches {
+		td := diffTables(atables.Get(k), btables.Get(k), &fieldStats, renames)
+		tableDiffs = append(tableDiffs, td)
+	}
+
+	for _, k := range tableDiff.RenamedKeys() {
+		td := diffTables(atables.Get(k), btables.Get(tableDiff.Renamed[k]), &fieldStats, renames)
+		td.RenamedFrom = k
+		tableDiffs = append(tableDiffs, td)
+	}
+
+	constraints := DiffDefinitions(
+		constraintDefinitions(modelConstraints(a.Schema)),
+		constraintDefinitions(modelConstraints(b.Schema)),
 	)
 
-	fmt.Fprintln(buff, "\n# Fields\n")
+	indexes := DiffDefinitions(
+		indexDefinitions(modelIndexes(a.Schema)),
+		indexDefinitions(modelIndexes(b.Schema)),
+	)
 
-	var diff *Diff
+	diff := &ModelDiff{
+		From:        a,
+		To:          b,
+		Tables:      tableDiff,
+		TableStats:  tableDiff.Stats(),
+		FieldStats:  &fieldStats,
+		Fields:      tableDiffs,
+		Constraints: constraints,
+		Indexes:     indexes,
+	}
 
-	for _, k := range tableDiff.Matches {
-		afields = a.Tables.Get(k).Fields
-		bfields = b.Tables.Get(k).Fields
+	diff.Compatibility = ClassifyDiff(diff)
 
-		fmt.Fprintln(buff)
-		fmt.Fprintf(buff, "## %s\n", k)
+	return diff
+}
 
-		diff = DiffStrings(afields.Names(), bfields.Names())
+// WriteMarkdown writes the differences as a Markdown report.
+func (d *ModelDiff) WriteMarkdown(out io.Writer) {
+	buff := bytes.NewBuffer(nil)
 
-		fmt.Fprintln(buff, "**All fields**\n")
+	fmt.Fprintln(buff, "# Compatibility")
+	d.Compatibility.WriteMarkdown(buff)
 
-		diff.Stats().Write(buff, Fall)
+	fmt.Fprintln(buff, "\n# Tables")
 
-		fmt.Fprintln(buff, "\n```")
-		diff.Write(buff, Fdiff)
-		fmt.Fprintln(buff, "```")
+	fmt.Fprintln(buff, "\n```")
+	d.Tables.Write(buff, Fdiff)
+	fmt.Fprintln(buff, "```")
 
-		fieldStats.Added += len(diff.Added)
-		fieldStats.Removed += len(diff.Removed)
+	fmt.Fprint(buff, "\n# Fields\n\n")
 
-		// Diff the matched fields.
-		if len(diff.Matches) > 0 {
-			for _, f := range diff.Matches {
-				adoc = afields.Get(f).Attrs
-				bdoc = bfields.Get(f).Attrs
+	for _, td := range d.Fields {
+		fmt.Fprintln(buff)
+		if td.RenamedFrom != "" {
+			fmt.Fprintf(buff, "## %s (renamed from %s)\n", td.Table, td.RenamedFrom)
+		} else {
+			fmt.Fprintf(buff, "## %s\n", td.Table)
+		}
 
-				diff = DiffAttrs(adoc, bdoc)
+		fmt.Fprint(buff, "**All fields**\n\n")
 
-				if len(diff.Added) > 0 || len(diff.Removed) > 0 || len(diff.Changes) > 0 {
-					fmt.Fprintln(buff)
-					fmt.Fprintf(buff, "### `%s`\n", f)
+		td.Stats.Write(buff, Fall)
 
-					diff.Stats().Write(buff, Fall)
+		fmt.Fprintln(buff, "\n```")
+		td.Fields.Write(buff, Fdiff)
+		fmt.Fprintln(buff, "```")
 
-					fmt.Fprintln(buff, "\n```")
-					diff.Write(buff, Fdiff)
-					fmt.Fprintln(buff, "```")
+		if len(td.Suggestions) > 0 {
+			fmt.Fprint(buff, "\n**Suggested renames**\n\n")
 
-					if len(diff.Changes) > 0 {
-						fieldStats.Changes += len(diff.Changes)
-					}
-				} else {
-					fieldStats.Matches += 1
-				}
+			for _, s := range td.Suggestions {
+				fmt.Fprintf(buff, "- `%s` &rarr; `%s` (confidence %.2f)\n", s.From, s.To, s.Confidence)
+			}
+		}
+
+		for _, fd := range td.Changes {
+			fmt.Fprintln(buff)
+			if fd.RenamedFrom != "" {
+				fmt.Fprintf(buff, "### `%s` (renamed from `%s`)\n", fd.Field, fd.RenamedFrom)
+			} else {
+				fmt.Fprintf(buff, "### `%s`\n", fd.Field)
 			}
+
+			fd.Stats.Write(buff, Fall)
+
+			fmt.Fprintln(buff, "\n```")
+			fd.Attrs.Write(buff, Fdiff)
+			fmt.Fprintln(buff, "```")
 		}
 	}
 
-	// Print to stdout
-	fmt.Fprintln(out, fmt.Sprintf("# %s &rarr; %s\n", a.Label, b.Label))
+	fmt.Fprintln(buff, "\n# Constraints")
+
+	fmt.Fprintln(buff, "\n```")
+	d.Constraints.Write(buff, Fdiff)
+	fmt.Fprintln(buff, "```")
+
+	fmt.Fprintln(buff, "\n# Indexes")
+
+	fmt.Fprintln(buff, "\n```")
+	d.Indexes.Write(buff, Fdiff)
+	fmt.Fprintln(buff, "```")
+
+	fmt.Fprintf(out, "# %s &rarr; %s\n\n", d.From, d.To)
+
+	fmt.Fprintf(out, "- Compatibility: **%s**\n", d.Compatibility.Verdict)
 
 	fmt.Fprintf(out, "- Tables: ")
-	tableDiff.Stats().Write(out, Fall)
+	d.TableStats.Write(out, Fall)
 
 	fmt.Fprintf(out, "\n- Fields: ")
-	fieldStats.Write(out, Fdiff)
+	d.FieldStats.Write(out, Fdiff)
+
+	fmt.Fprintf(out, "\n- Constraints: ")
+	d.Constraints.Stats().Write(out, Fall)
+
+	fmt.Fprintf(out, "\n- Indexes: ")
+	d.Indexes.Stats().Write(out, Fall)
 
-	fmt.Fprintln(out, "\n")
+	fmt.Fprint(out, "\n\n")
 
 	io.Copy(out, buff)
 }
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// ERDOptions restricts a diagram to a subset of the tables of a model.
+type ERDOptions struct {
+	// Names of the tables to include. All tables are included if empty.
+	Tables []string
+
+	// Table to center the diagram on. Tables within the given number of
+	// foreign key hops are included, regardless of direction.
+	Around string
+	Hops   int
+}
+
+// ERD is an entity-relationship diagram of the tables of a model and the
+// foreign keys between them.
+type ERD struct {
+	Model  *dms.Model
+	Tables []*dms.Table
+
+	// Foreign keys of the tables of the diagram.
+	Keys []*foreignKey
+
+	// Tables outside the selection referenced by the foreign keys, which are
+	// drawn by name only.
+	Stubs []string
+
+	schema map[string]*tableSchema
+}
+
+// tableNeighbors returns the tables linked to each table by a foreign key in
+// either direction.
+func tableNeighbors(schema map[string]*tableSchema) map[string]map[string]bool {
+	adj := make(map[string]map[string]bool)
+
+	link := func(a, b string) {
+		if adj[a] == nil {
+			adj[a] = make(map[string]bool)
+		}
+
+		adj[a][b] = true
+	}
+
+	for t, ts := range schema {
+		for _, fk := range ts.ForeignKeys {
+			r := strings.ToLower(fk.TargetTable)
+
+			link(t, r)
+			link(r, t)
+		}
+	}
+
+	return adj
+}
+
+// NewERD selects the tables of the diagram. An error is returned if an
+// option names a table that is not in the model.
+func NewERD(m *dms.Model, opts *ERDOptions) (*ERD, error) {
+	schema := indexSchema(m.Schema)
+
+	include := make(map[string]bool)
+
+	for _, n := range opts.Tables {
+		if m.Tables.Get(n) == nil {
+			return nil, fmt.Errorf("unknown table %q", n)
+		}
+
+		include[strings.ToLower(n)] = true
+	}
+
+	if opts.Around != "" {
+		if m.Tables.Get(opts.Around) == nil {
+			return nil, fmt.Errorf("unknown table %q", opts.Around)
+		}
+
+		adj := tableNeighbors(schema)
+
+		start := strings.ToLower(opts.Around)
+		seen := map[string]bool{start: true}
+		frontier := []string{start}
+
+		for i := 0; i < opts.Hops; i++ {
+			var next []string
+
+			for _, t := range frontier {
+				for r := range adj[t] {
+					if !seen[r] {
+						seen[r] = true
+						next = append(next, r)
+					}
+				}
+			}
+
+			frontier = next
+		}
+
+		// Both options narrow the diagram.
+		if len(include) == 0 {
+			include = seen
+		} else {
+			for t := range include {
+				if !seen[t] {
+					delete(include, t)
+				}
+			}
+		}
+	}
+
+	e := &ERD{
+		Model:  m,
+		schema: schema,
+	}
+
+	for _, t := range m.Tables.List() {
+		if len(include) == 0 || include[strings.ToLower(t.Name)] {
+			e.Tables = append(e.Tables, t)
+		}
+	}
+
+	stubs := make(map[string]bool)
+
+	for _, t := range e.Tables {
+		for _, fk := range groupForeignKeys(e.tableSchema(t).ForeignKeys) {
+			e.Keys = append(e.Keys, fk)
+
+			if r := strings.ToLower(fk.TargetTable); len(include) > 0 && !include[r] && !stubs[r] {
+				stubs[r] = true
+
+				if rt := m.Tables.Get(r); rt != nil {
+					e.Stubs = append(e.Stubs, rt.Name)
+				} else {
+					e.Stubs = append(e.Stubs, fk.TargetTable)
+				}
+			}
+		}
+	}
+
+	sort.Strings(e.Stubs)
+
+	return e, nil
+}
+
+// isStub returns true if the table is drawn by name only.
+func (e *ERD) isStub(name string) bool {
+	return containsFold(e.Stubs, name)
+}
+
+func (e *ERD) tableSchema(t *dms.Table) *tableSchema {
+	return schemaForTable(e.schema, t.Name)
+}
+
+// fieldKeys returns the PK, FK and UK markers of a field.
+func (e *ERD) fieldKeys(t *dms.Table, f *dms.Field) []string {
+	var keys []string
+
+	ts := e.tableSchema(t)
+
+	if ts.PrimaryKey != nil && containsFold(ts.PrimaryKey.Fields, f.Name) {
+		keys = append(keys, "PK")
+	}
+
+	for _, fk := range ts.ForeignKeys {
+		if strings.EqualFold(fk.SourceField, f.Name) {
+			keys = append(keys, "FK")
+			break
+		}
+	}
+
+	for _, un := range ts.Uniques {
+		if containsFold(un.Fields, f.Name) {
+			keys = append(keys, "UK")
+			break
+		}
+	}
+
+	return keys
+}
+
+// optional returns true if any source field of the foreign key may be null.
+func (e *ERD) optional(fk *foreignKey) bool {
+	t := e.Model.Tables.Get(fk.Table)
+	ts := e.tableSchema(t)
+
+	for _, n := range fk.Fields {
+		if f := t.Fields.Get(n); f == nil || !isNotNull(f, ts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldType returns the type of the field including its size.
+func fieldType(f *dms.Field) string {
+	switch {
+	case f.Length > 0:
+		return fmt.Sprintf("%s(%d)", f.Type, f.Length)
+	case f.Precision > 0 && f.Scale > 0:
+		return fmt.Sprintf("%s(%d,%d)", f.Type, f.Precision, f.Scale)
+	case f.Precision > 0:
+		return fmt.Sprintf("%s(%d)", f.Type, f.Precision)
+	}
+
+	return f.Type
+}
+
+// WriteDot writes the diagram in the Graphviz DOT language. Tables are drawn
+// as HTML-like labels with a port per field for the foreign key edges.
+func (e *ERD) WriteDot(w io.Writer) {
+	fmt.Fprintf(w, "digraph %q {\n", e.Model.String())
+	fmt.Fprint(w, "  rankdir=LR;\n")
+	fmt.Fprint(w, "  node [shape=plaintext, fontname=\"Helvetica\", fontsize=10];\n")
+	fmt.Fprint(w, "  edge [fontname=\"Helvetica\", fontsize=9];\n")
+
+	for _, t := range e.Tables {
+		fmt.Fprintf(w, "\n  %q [label=<\n", strings.ToLower(t.Name))
+		fmt.Fprint(w, "    <table border=\"0\" cellborder=\"1\" cellspacing=\"0\" cellpadding=\"4\">\n")
+		fmt.Fprintf(w, "      <tr><td bgcolor=\"#dddddd\" colspan=\"2\"><b>%s</b></td></tr>\n", html.EscapeString(t.Name))
+
+		for _, f := range t.Fields.List() {
+			name := html.EscapeString(f.Name)
+
+			if keys := e.fieldKeys(t, f); len(keys) > 0 {
+				name = fmt.Sprintf("%s <i>%s</i>", name, strings.Join(keys, ", "))
+			}
+
+			fmt.Fprintf(w, "      <tr><td port=%q align=\"left\">%s</td><td align=\"left\">%s</td></tr>\n", strings.ToLower(f.Name), name, html.EscapeString(fieldType(f)))
+		}
+
+		fmt.Fprint(w, "    </table>\n  >];\n")
+	}
+
+	for _, n := range e.Stubs {
+		fmt.Fprintf(w, "\n  %q [label=%q, shape=box, style=dashed];\n", strings.ToLower(n), n)
+	}
+
+	if len(e.Keys) > 0 {
+		fmt.Fprintln(w)
+	}
+
+	for _, fk := range e.Keys {
+		style := "solid"
+
+		if e.optional(fk) {
+			style = "dashed"
+		}
+
+		// Stubs have no field ports.
+		target := fmt.Sprintf("%q:%q", strings.ToLower(fk.TargetTable), strings.ToLower(fk.TargetFields[0]))
+
+		if e.isStub(fk.TargetTable) {
+			target = fmt.Sprintf("%q", strings.ToLower(fk.TargetTable))
+		}
+
+		fmt.Fprintf(w, "  %q:%q -> %s [label=%q, style=%s];\n",
+			strings.ToLower(fk.Table), strings.ToLower(fk.Fields[0]),
+			target, fk.Name, style)
+	}
+
+	fmt.Fprint(w, "}\n")
+}
+
+var mermaidIdentRe = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// mermaidIdent replaces the characters Mermaid does not allow in names.
+func mermaidIdent(s string) string {
+	return mermaidIdentRe.ReplaceAllString(s, "_")
+}
+
+// crowsFoot returns the relationship of the referenced table to the
+// referencing table, e.g. exactly one to zero or more.
+func (e *ERD) crowsFoot(fk *foreignKey) string {
+	if e.optional(fk) {
+		return "|o--o{"
+	}
+
+	return "||--o{"
+}
+
+// WriteMermaid writes the diagram as a Mermaid erDiagram.
+func (e *ERD) WriteMermaid(w io.Writer) {
+	fmt.Fprint(w, "erDiagram\n")
+
+	for _, t := range e.Tables {
+		fmt.Fprintf(w, "  %s {\n", mermaidIdent(t.Name))
+
+		for _, f := range t.Fields.List() {
+			typ := "unknown"
+
+			if f.Type != "" {
+				typ = mermaidIdent(f.Type)
+			}
+
+			fmt.Fprintf(w, "    %s %s", typ, mermaidIdent(f.Name))
+
+			if keys := e.fieldKeys(t, f); len(keys) > 0 {
+				fmt.Fprintf(w, " %s", strings.Join(keys, ", "))
+			}
+
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprint(w, "  }\n")
+	}
+
+	// Entities without attributes are drawn by name.
+	for _, n := range e.Stubs {
+		fmt.Fprintf(w, "  %s\n", mermaidIdent(n))
+	}
+
+	for _, fk := range e.Keys {
+		fmt.Fprintf(w, "  %s %s %s : %q\n", mermaidIdent(fk.TargetTable), e.crowsFoot(fk), mermaidIdent(fk.Table), fk.Name)
+	}
+}
+
+// WritePlantUML writes the diagram as a PlantUML entity diagram. Primary key
+// fields are listed first and mandatory fields are marked with an asterisk.
+func (e *ERD) WritePlantUML(w io.Writer) {
+	fmt.Fprint(w, "@startuml\n")
+	fmt.Fprintf(w, "title %s\n", e.Model)
+	fmt.Fprint(w, "hide circle\n")
+	fmt.Fprint(w, "skinparam linetype ortho\n")
+
+	for _, t := range e.Tables {
+		ts := e.tableSchema(t)
+
+		var keys, rest []*dms.Field
+
+		for _, f := range t.Fields.List() {
+			if ts.PrimaryKey != nil && containsFold(ts.PrimaryKey.Fields, f.Name) {
+				keys = append(keys, f)
+			} else {
+				rest = append(rest, f)
+			}
+		}
+
+		fmt.Fprintf(w, "\nentity %q as %s {\n", t.Name, mermaidIdent(t.Name))
+
+		for i, fields := range [][]*dms.Field{keys, rest} {
+			if i == 1 && len(keys) > 0 {
+				fmt.Fprint(w, "  --\n")
+			}
+
+			for _, f := range fields {
+				mark := ""
+
+				if isNotNull(f, ts) {
+					mark = "* "
+				}
+
+				fmt.Fprintf(w, "  %s%s : %s", mark, f.Name, fieldType(f))
+
+				if ks := e.fieldKeys(t, f); len(ks) > 0 {
+					fmt.Fprintf(w, " <<%s>>", strings.Join(ks, ", "))
+				}
+
+				fmt.Fprintln(w)
+			}
+		}
+
+		fmt.Fprint(w, "}\n")
+	}
+
+	for _, n := range e.Stubs {
+		fmt.Fprintf(w, "\nentity %q as %s #line.dashed {\n}\n", n, mermaidIdent(n))
+	}
+
+	if len(e.Keys) > 0 {
+		fmt.Fprintln(w)
+	}
+
+	for _, fk := range e.Keys {
+		fmt.Fprintf(w, "%s %s %s", mermaidIdent(fk.TargetTable), e.crowsFoot(fk), mermaidIdent(fk.Table))
+
+		if fk.Name != "" {
+			fmt.Fprintf(w, " : %s", fk.Name)
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprint(w, "@enduml\n")
+}
+
+// Writers of the diagram by format.
+var erdWriters = map[string]func(*ERD, io.Writer){
+	"dot":      (*ERD).WriteDot,
+	"mermaid":  (*ERD).WriteMermaid,
+	"plantuml": (*ERD).WritePlantUML,
+}
+
+// ERDFormats returns the sorted names of the diagram formats.
+func ERDFormats() []string {
+	var names []string
+
+	for n := range erdWriters {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// WriteERDMarkdown writes the diagram as a Mermaid code block.
+func WriteERDMarkdown(w io.Writer, e *ERD) {
+	fmt.Fprintf(w, "# %s\n\n", e.Model)
+	fmt.Fprint(w, "```mermaid\n")
+	e.WriteMermaid(w)
+	fmt.Fprint(w, "```\n")
+}
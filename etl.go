@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// sqlComment collapses the whitespace of a comment so it fits on a line.
+func sqlComment(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// joinCondition returns the join condition of the step with the identifiers
+// quoted for the dialect.
+func joinCondition(d Dialect, s *JoinStep) string {
+	conds := make([]string, len(s.FromFields))
+
+	for i, f := range s.FromFields {
+		conds[i] = fmt.Sprintf("%s.%s = %s.%s", d.Quote(s.From), d.Quote(f), d.Quote(s.To), d.Quote(s.ToFields[i]))
+	}
+
+	return strings.Join(conds, " AND ")
+}
+
+// WriteETLSQL writes a skeleton script in the SQL dialect which loads the
+// tables of the target model from the tables of the source model they are
+// mapped from. The tables are loaded in foreign key dependency order.
+func WriteETLSQL(w io.Writer, source, target *dms.Model, d Dialect) {
+	sschema := indexSchema(source.Schema)
+	schema := indexSchema(target.Schema)
+	graph := NewTableGraph(source)
+
+	fmt.Fprintf(w, "-- ETL from %s (%s) to %s (%s)\n", source, source.URLPath(), target, target.URLPath())
+	fmt.Fprintf(w, "-- Dialect: %s\n", d.Name())
+	fmt.Fprintf(w, "-- Generated by %s %s\n", serviceName, progVersion)
+
+	for _, t := range sortTablesByDependency(target, schema) {
+		fmt.Fprintln(w)
+		writeETLInsert(w, d, graph, sschema, t, schemaForTable(schema, t.Name))
+	}
+}
+
+// writeETLInsert writes the INSERT INTO ... SELECT statement of a target
+// table. The table the most fields are mapped from is selected from and
+// the other source tables are joined along the shortest path of references.
+// Mapping comments and the warnings of the conversion from the source field
+// are written above the columns. Required columns without a mapping are
+// selected as NULL and marked as TODO.
+func writeETLInsert(w io.Writer, d Dialect, g *TableGraph, sschema map[string]*tableSchema, t *dms.Table, ts *tableSchema) {
+	var columns []*dms.Field
+
+	mappings := make(map[*dms.Field][]*dms.Mapping)
+	counts := make(map[string]int)
+	sources := make(map[string]*dms.Table)
+
+	for _, f := range t.Fields.List() {
+		mps := mappingsTo(f, g.Model)
+
+		if len(mps) == 0 && !isNotNull(f, ts) {
+			continue
+		}
+
+		columns = append(columns, f)
+		mappings[f] = mps
+
+		for _, mp := range mps {
+			st := mp.Field.Table
+			counts[st.Name]++
+			sources[st.Name] = st
+		}
+	}
+
+	if len(sources) == 0 {
+		fmt.Fprintf(w, "-- TODO: no fields of %s are mapped from %s\n", d.Quote(t.Name), g.Model)
+		return
+	}
+
+	var names []string
+
+	for n := range sources {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	from := names[0]
+
+	for _, n := range names[1:] {
+		if counts[n] > counts[from] {
+			from = n
+		}
+	}
+
+	fmt.Fprintf(w, "INSERT INTO %s (\n", d.Quote(t.Name))
+
+	for i, f := range columns {
+		sep := ","
+
+		if i == len(columns)-1 {
+			sep = ""
+		}
+
+		fmt.Fprintf(w, "    %s%s\n", d.Quote(f.Name), sep)
+	}
+
+	fmt.Fprint(w, ")\nSELECT\n")
+
+	for i, f := range columns {
+		sep := ","
+
+		if i == len(columns)-1 {
+			sep = ""
+		}
+
+		mps := mappings[f]
+
+		if len(mps) == 0 {
+			fmt.Fprint(w, "    -- TODO: required column is not mapped\n")
+			fmt.Fprintf(w, "    NULL AS %s%s\n", d.Quote(f.Name), sep)
+			continue
+		}
+
+		mp := mps[0]
+
+		if c := sqlComment(mp.Comment); c != "" {
+			fmt.Fprintf(w, "    -- %s\n", c)
+		}
+
+		// The mapping may be declared in either direction so the
+		// warnings are checked from the source field.
+		sts := schemaForTable(sschema, mp.Field.Table.Name)
+
+		for _, warning := range checkMapping(mp.Field, f, sts, ts) {
+			fmt.Fprintf(w, "    -- WARNING: %s\n", warning)
+		}
+
+		for _, other := range mps[1:] {
+			fmt.Fprintf(w, "    -- TODO: also mapped from %s.%s\n", d.Quote(other.Field.Table.Name), d.Quote(other.Field.Name))
+		}
+
+		fmt.Fprintf(w, "    %s.%s AS %s%s\n", d.Quote(mp.Field.Table.Name), d.Quote(mp.Field.Name), d.Quote(f.Name), sep)
+	}
+
+	var joins, todos []string
+
+	joined := map[string]bool{strings.ToLower(from): true}
+
+	for _, n := range names {
+		if joined[strings.ToLower(n)] {
+			continue
+		}
+
+		paths := g.ShortestPaths(sources[from], sources[n], 1)
+
+		if len(paths) == 0 {
+			todos = append(todos, fmt.Sprintf("-- TODO: join %s, it is not connected to %s by references", d.Quote(n), d.Quote(from)))
+			continue
+		}
+
+		for _, s := range paths[0] {
+			if joined[strings.ToLower(s.To)] {
+				continue
+			}
+
+			joined[strings.ToLower(s.To)] = true
+
+			joins = append(joins, fmt.Sprintf("JOIN %s ON %s", d.Quote(s.To), joinCondition(d, s)))
+		}
+	}
+
+	for _, todo := range todos {
+		fmt.Fprintln(w, todo)
+	}
+
+	fmt.Fprintf(w, "FROM %s", d.Quote(from))
+
+	for _, j := range joins {
+		fmt.Fprintf(w, "\n%s", j)
+	}
+
+	fmt.Fprint(w, ";\n")
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// The expanded representations shadow the tables and fields of the embedded
+// values to add the links of each field.
+type expandedModel struct {
+	*dms.Model
+	Tables []*expandedTable `json:"tables"`
+}
+
+type expandedTable struct {
+	*dms.Table
+	Fields []*expandedField `json:"fields"`
+}
+
+type expandedField struct {
+	*dms.Field
+	Links *dms.FieldLinks `json:"links,omitempty"`
+}
+
+func expandField(f *dms.Field, e dms.Expand) *expandedField {
+	return &expandedField{
+		Field: f,
+		Links: e.Links(f),
+	}
+}
+
+func expandTable(t *dms.Table, e dms.Expand) *expandedTable {
+	fields := t.Fields.List()
+
+	x := &expandedTable{
+		Table:  t,
+		Fields: make([]*expandedField, len(fields)),
+	}
+
+	for i, f := range fields {
+		x.Fields[i] = expandField(f, e)
+	}
+
+	return x
+}
+
+func expandModel(m *dms.Model, e dms.Expand) *expandedModel {
+	tables := m.Tables.List()
+
+	x := &expandedModel{
+		Model:  m,
+		Tables: make([]*expandedTable, len(tables)),
+	}
+
+	for i, t := range tables {
+		x.Tables[i] = expandTable(t, e)
+	}
+
+	return x
+}
+
+func expandModels(ms []*dms.Model, e dms.Expand) []*expandedModel {
+	x := make([]*expandedModel, len(ms))
+
+	for i, m := range ms {
+		x[i] = expandModel(m, e)
+	}
+
+	return x
+}
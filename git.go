@@ -0,0 +1,488 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+)
+
+var ErrUnknownRef = errors.New("repo: unknown branch or tag")
+
+// CommitInfo identifies the commit a change was made in.
+type CommitInfo struct {
+	SHA1   string `json:"sha1"`
+	Author string `json:"author"`
+	Email  string `json:"email"`
+
+	// Time is the committer time, which orders the commits of a branch and
+	// resolves dates to commits.
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+}
+
+// GitClient performs the git operations on the checkouts of the repos. The
+// checkout is identified by its path.
+type GitClient interface {
+	// Clone clones the branch or tag of the repository into the path. A
+	// depth greater than zero limits the history to that many commits.
+	Clone(url, ref, path string, depth int) error
+
+	// HasOrigin returns true if the checkout has an origin remote.
+	HasOrigin(path string) (bool, error)
+
+	// Pull fetches the branch or tag from the origin and checks it out. A
+	// branch is only fast-forwarded.
+	Pull(path, ref string, depth int) error
+
+	// Head returns the commit checked out.
+	Head(path string) (*CommitInfo, error)
+
+	// Resolve returns the SHA1 of the commit a revision refers to, such as
+	// a SHA1, possibly abbreviated, or a ref.
+	Resolve(path, rev string) (string, error)
+
+	// ResolveTime returns the SHA1 of the last commit of the checked out
+	// ref that was committed at or before the time.
+	ResolveTime(path string, t time.Time) (string, error)
+
+	// Log returns the commits of the checked out ref that changed files
+	// under the subpath, oldest first.
+	Log(path, subpath string) ([]*CommitInfo, error)
+
+	// Extract writes the files of the commit into the directory. If paths
+	// are given only the files under them are written.
+	Extract(path, sha, dir string, paths ...string) error
+}
+
+// defaultGitClient is the client of the parsed repos.
+var defaultGitClient GitClient = goGitClient{}
+
+// goGitClient implements the git operations in-process so the git binary
+// is not required.
+type goGitClient struct{}
+
+// localLoader loads the storage of local repositories, bare or not, so they
+// are served in-process rather than by git-upload-pack.
+type localLoader struct{}
+
+func (localLoader) Load(ep *transport.Endpoint) (storer.Storer, error) {
+	r, err := git.PlainOpen(ep.Path)
+
+	if err != nil {
+		return nil, transport.ErrRepositoryNotFound
+	}
+
+	return r.Storer, nil
+}
+
+// installLocalTransport replaces the transport of the file protocol when
+// the client first clones or fetches. go-git looks transports up in a
+// process-wide registry, the options of clones and fetches cannot name one,
+// so it applies to every go-git repository of the process. The service does
+// not use go-git otherwise.
+var installLocalTransport sync.Once
+
+func useLocalTransport() {
+	installLocalTransport.Do(func() {
+		client.InstallProtocol("file", server.NewClient(localLoader{}))
+	})
+}
+
+func newCommitInfo(c *object.Commit) *CommitInfo {
+	msg := c.Message
+
+	// Only the subject is kept.
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		msg = msg[:i]
+	}
+
+	return &CommitInfo{
+		SHA1:    c.Hash.String(),
+		Author:  c.Author.Name,
+		Email:   c.Author.Email,
+		Time:    c.Committer.When,
+		Message: strings.TrimSpace(msg),
+	}
+}
+
+// endOfHistory returns true if the error was caused by reaching the first
+// commit of a shallow clone, the parents of which are not fetched.
+func endOfHistory(err error) bool {
+	return err == plumbing.ErrObjectNotFound
+}
+
+// remoteDepth returns the depth of fetches from the URL. Local repositories
+// are always fetched in full, as with git.
+func remoteDepth(url string, depth int) int {
+	ep, err := transport.NewEndpoint(url)
+
+	if err != nil || ep.Protocol == "file" {
+		return 0
+	}
+
+	return depth
+}
+
+func (goGitClient) Clone(url, ref, path string, depth int) error {
+	useLocalTransport()
+
+	opts := &git.CloneOptions{
+		URL:           url,
+		ReferenceName: plumbing.NewBranchReferenceName(ref),
+		SingleBranch:  true,
+		Depth:         remoteDepth(url, depth),
+	}
+
+	_, err := git.PlainClone(path, false, opts)
+
+	// The ref is not a branch, try the tags.
+	if isNoMatchingRef(err) {
+		os.RemoveAll(path)
+
+		opts.ReferenceName = plumbing.NewTagReferenceName(ref)
+		_, err = git.PlainClone(path, false, opts)
+	}
+
+	if isNoMatchingRef(err) {
+		return ErrUnknownRef
+	}
+
+	return err
+}
+
+func isNoMatchingRef(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if err == plumbing.ErrReferenceNotFound {
+		return true
+	}
+
+	var nerr git.NoMatchingRefSpecError
+
+	return errors.As(err, &nerr)
+}
+
+func (goGitClient) HasOrigin(path string) (bool, error) {
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return false, err
+	}
+
+	_, err = r.Remote(git.DefaultRemoteName)
+
+	if err == git.ErrRemoteNotFound {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (goGitClient) Pull(path, ref string, depth int) error {
+	useLocalTransport()
+
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := r.Remote(git.DefaultRemoteName)
+
+	if err != nil {
+		return err
+	}
+
+	if urls := remote.Config().URLs; len(urls) > 0 {
+		depth = remoteDepth(urls[0], depth)
+	}
+
+	err = r.Fetch(&git.FetchOptions{
+		RemoteName: git.DefaultRemoteName,
+		Tags:       git.AllTags,
+		Depth:      depth,
+	})
+
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return err
+	}
+
+	w, err := r.Worktree()
+
+	if err != nil {
+		return err
+	}
+
+	// Tags are checked out detached, branches are fast-forwarded.
+	if tag, err := r.Reference(plumbing.NewTagReferenceName(ref), true); err == nil {
+		sha, err := r.ResolveRevision(plumbing.Revision(tag.Name()))
+
+		if err != nil {
+			return err
+		}
+
+		return w.Checkout(&git.CheckoutOptions{Hash: *sha})
+	}
+
+	err = w.Pull(&git.PullOptions{
+		RemoteName:    git.DefaultRemoteName,
+		ReferenceName: plumbing.NewBranchReferenceName(ref),
+		SingleBranch:  true,
+		Depth:         depth,
+	})
+
+	if err == git.NoErrAlreadyUpToDate {
+		return nil
+	}
+
+	return err
+}
+
+func (goGitClient) Head(path string) (*CommitInfo, error) {
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := r.Head()
+
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := r.CommitObject(head.Hash())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newCommitInfo(c), nil
+}
+
+func (goGitClient) Resolve(path, rev string) (string, error) {
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	sha, err := r.ResolveRevision(plumbing.Revision(rev))
+
+	if err != nil {
+		return "", ErrUnknownCommit
+	}
+
+	return sha.String(), nil
+}
+
+func (goGitClient) ResolveTime(path string, t time.Time) (string, error) {
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	iter, err := r.Log(&git.LogOptions{Order: git.LogOrderCommitterTime})
+
+	if err != nil {
+		return "", err
+	}
+
+	var sha string
+
+	err = iter.ForEach(func(c *object.Commit) error {
+		if c.Committer.When.After(t) {
+			return nil
+		}
+
+		sha = c.Hash.String()
+
+		return storer.ErrStop
+	})
+
+	if err != nil && !endOfHistory(err) {
+		return "", err
+	}
+
+	if sha == "" {
+		return "", ErrUnknownCommit
+	}
+
+	return sha, nil
+}
+
+// underPath returns true if the slash-separated file is under one of the
+// paths. All files are under an empty list of paths.
+func underPath(file string, paths []string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+
+	for _, p := range paths {
+		p = strings.Trim(filepath.ToSlash(p), "/")
+
+		if p == "" || p == "." || file == p || strings.HasPrefix(file, p+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changedUnder returns true if the commit changed files under the paths
+// compared to its first parent. The first commit of a shallow clone is
+// compared to an empty tree as is the first commit of the history.
+func changedUnder(c *object.Commit, paths []string) (bool, error) {
+	tree, err := c.Tree()
+
+	if err != nil {
+		return false, err
+	}
+
+	var ptree *object.Tree
+
+	if c.NumParents() > 0 {
+		p, err := c.Parent(0)
+
+		if err == nil {
+			ptree, err = p.Tree()
+		}
+
+		if err != nil && !endOfHistory(err) {
+			return false, err
+		}
+	}
+
+	changes, err := object.DiffTree(ptree, tree)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, ch := range changes {
+		name := ch.To.Name
+
+		if name == "" {
+			name = ch.From.Name
+		}
+
+		if underPath(name, paths) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (goGitClient) Log(path, subpath string) ([]*CommitInfo, error) {
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{subpath}
+
+	// The path filter of go-git drops the first commit of a shallow clone,
+	// the commits are filtered by their changes instead.
+	iter, err := r.Log(&git.LogOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []*CommitInfo
+
+	err = iter.ForEach(func(c *object.Commit) error {
+		ok, err := changedUnder(c, paths)
+
+		if ok {
+			commits = append(commits, newCommitInfo(c))
+		}
+
+		return err
+	})
+
+	if err != nil && !endOfHistory(err) {
+		return nil, err
+	}
+
+	// Reverse so the oldest commit is first.
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+
+	return commits, nil
+}
+
+func (goGitClient) Extract(path, sha, dir string, paths ...string) error {
+	r, err := git.PlainOpen(path)
+
+	if err != nil {
+		return err
+	}
+
+	c, err := r.CommitObject(plumbing.NewHash(sha))
+
+	if err != nil {
+		return ErrUnknownCommit
+	}
+
+	tree, err := c.Tree()
+
+	if err != nil {
+		return err
+	}
+
+	return tree.Files().ForEach(func(f *object.File) error {
+		// Only the definitions files are parsed.
+		if f.Mode != filemode.Regular && f.Mode != filemode.Executable {
+			return nil
+		}
+
+		if !underPath(f.Name, paths) {
+			return nil
+		}
+
+		name := filepath.Join(dir, filepath.FromSlash(f.Name))
+
+		if err := os.MkdirAll(filepath.Dir(name), os.ModeDir|0775); err != nil {
+			return err
+		}
+
+		rd, err := f.Reader()
+
+		if err != nil {
+			return err
+		}
+
+		defer rd.Close()
+
+		out, err := os.Create(name)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(out, rd)
+		out.Close()
+
+		return err
+	})
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// fakeGitClient checks out nothing. The commit checked out and the commits
+// revisions resolve to are set by the test.
+type fakeGitClient struct {
+	cloneErr error
+	head     string
+	refs     map[string]string
+
+	clones int
+	pulls  int
+}
+
+func (c *fakeGitClient) Clone(url, ref, path string, depth int) error {
+	c.clones++
+
+	if c.cloneErr != nil {
+		// A failed clone may leave files behind.
+		os.MkdirAll(path, 0755)
+		return c.cloneErr
+	}
+
+	return os.MkdirAll(filepath.Join(path, ".git"), 0755)
+}
+
+func (c *fakeGitClient) HasOrigin(path string) (bool, error) {
+	return true, nil
+}
+
+func (c *fakeGitClient) Pull(path, ref string, depth int) error {
+	c.pulls++
+	return nil
+}
+
+func (c *fakeGitClient) Head(path string) (*CommitInfo, error) {
+	return &CommitInfo{SHA1: c.head}, nil
+}
+
+func (c *fakeGitClient) Resolve(path, rev string) (string, error) {
+	if sha, ok := c.refs[rev]; ok {
+		return sha, nil
+	}
+
+	return "", ErrUnknownCommit
+}
+
+func (c *fakeGitClient) ResolveTime(path string, t time.Time) (string, error) {
+	return "", ErrUnknownCommit
+}
+
+func (c *fakeGitClient) Log(path, subpath string) ([]*CommitInfo, error) {
+	return nil, nil
+}
+
+func (c *fakeGitClient) Extract(path, sha, dir string, paths ...string) error {
+	return nil
+}
+
+func TestRepoUpdate(t *testing.T) {
+	fake := &fakeGitClient{cloneErr: ErrUnknownRef}
+
+	r := &Repo{
+		URL:    "https://example.com/data-models",
+		Ref:    "nope",
+		path:   filepath.Join(t.TempDir(), "data-models"),
+		git:    true,
+		client: fake,
+	}
+
+	if _, err := r.update(); err == nil {
+		t.Fatal("expected the clone to fail")
+	}
+
+	if _, err := os.Stat(r.path); !os.IsNotExist(err) {
+		t.Errorf("expected the failed clone to be removed, got %v", err)
+	}
+
+	fake.cloneErr = nil
+	fake.head = "a"
+
+	if ok, err := r.update(); err != nil || !ok {
+		t.Fatalf("expected the clone to change the repo, got %t, %v", ok, err)
+	}
+
+	if ok, err := r.update(); err != nil || ok {
+		t.Errorf("expected the pull not to change the repo, got %t, %v", ok, err)
+	}
+
+	fake.head = "b"
+
+	if ok, err := r.update(); err != nil || !ok {
+		t.Errorf("expected the pull to change the repo, got %t, %v", ok, err)
+	}
+
+	if fake.clones != 2 || fake.pulls != 2 {
+		t.Errorf("expected 2 clones and 2 pulls, got %d and %d", fake.clones, fake.pulls)
+	}
+}
+
+func TestRepoUpdateLocalRef(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(dir, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	fake := &fakeGitClient{
+		head: "a",
+		refs: map[string]string{"HEAD": "a", "develop": "b"},
+	}
+
+	r := &Repo{
+		URL:         dir,
+		Ref:         "develop",
+		path:        dir,
+		git:         true,
+		client:      fake,
+		explicitRef: true,
+	}
+
+	// The working tree is not at the ref.
+	if _, err := r.update(); err == nil || !r.offRef {
+		t.Errorf("expected the repo to be off its ref, got %v", err)
+	}
+
+	fake.refs["develop"] = "a"
+
+	if ok, err := r.update(); err != nil || !ok || r.offRef {
+		t.Errorf("expected the repo to be back at its ref, got %t, %v", ok, err)
+	}
+
+	if fake.clones != 0 {
+		t.Errorf("expected the local repo not to be cloned, got %d clones", fake.clones)
+	}
+}
+
+// newTestOrigin returns a repository with two commits on master and the
+// tag v1 at the first.
+func newTestOrigin(t *testing.T) (*testCommitter, string, string) {
+	c := newTestCommitter(t)
+
+	first := c.commit("jane", "First", map[string]string{"a.txt": "1"})
+	second := c.commit("jane", "Second", map[string]string{"a.txt": "2"})
+
+	if _, err := c.repo.CreateTag("v1", plumbing.NewHash(first), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	return c, first, second
+}
+
+// assertHead fails the test if the checkout is not at the commit.
+func assertHead(t *testing.T, path, sha string) {
+	t.Helper()
+
+	c, err := goGitClient{}.Head(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.SHA1 != sha {
+		t.Errorf("expected %s checked out, got %s", sha, c.SHA1)
+	}
+}
+
+func TestGoGitClone(t *testing.T) {
+	origin, first, second := newTestOrigin(t)
+
+	dir := t.TempDir()
+	client := goGitClient{}
+
+	tests := []struct {
+		ref string
+		sha string
+	}{
+		{"master", second},
+
+		// Not a branch, cloned from the tags.
+		{"v1", first},
+	}
+
+	for _, test := range tests {
+		path := filepath.Join(dir, test.ref)
+
+		if err := client.Clone(origin.dir, test.ref, path, 0); err != nil {
+			t.Errorf("%s: %s", test.ref, err)
+			continue
+		}
+
+		assertHead(t, path, test.sha)
+	}
+
+	if err := client.Clone(origin.dir, "nope", filepath.Join(dir, "nope"), 0); err != ErrUnknownRef {
+		t.Errorf("expected %v, got %v", ErrUnknownRef, err)
+	}
+}
+
+func TestGoGitPull(t *testing.T) {
+	origin, first, _ := newTestOrigin(t)
+
+	dir := t.TempDir()
+	client := goGitClient{}
+
+	branch := filepath.Join(dir, "master")
+	tag := filepath.Join(dir, "v1")
+
+	for _, ref := range []string{"master", "v1"} {
+		if err := client.Clone(origin.dir, ref, filepath.Join(dir, ref), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	third := origin.commit("jane", "Third", map[string]string{"a.txt": "3"})
+
+	// The branch is fast-forwarded, the tag stays.
+	if err := client.Pull(branch, "master", 0); err != nil {
+		t.Fatalf("master: %s", err)
+	}
+
+	assertHead(t, branch, third)
+
+	if err := client.Pull(tag, "v1", 0); err != nil {
+		t.Fatalf("v1: %s", err)
+	}
+
+	assertHead(t, tag, first)
+
+	// A tag created after the clone is fetched and checked out.
+	if _, err := origin.repo.CreateTag("v2", plumbing.NewHash(third), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Pull(tag, "v2", 0); err != nil {
+		t.Fatalf("v2: %s", err)
+	}
+
+	assertHead(t, tag, third)
+}
+
+func TestGoGitShallowHistory(t *testing.T) {
+	c := newTestCommitter(t)
+
+	first := c.commit("jane", "First", map[string]string{"a.txt": "1"})
+	second := c.commit("jane", "Second", map[string]string{"a.txt": "2"})
+	third := c.commit("jane", "Third", map[string]string{"a.txt": "3"})
+
+	// Cut the history at the second commit as a shallow clone does.
+	obj := filepath.Join(c.dir, ".git", "objects", first[:2], first[2:])
+
+	if err := os.Remove(obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(c.dir, ".git", "shallow"), []byte(second+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	client := goGitClient{}
+
+	commits, err := client.Log(c.dir, "")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(commits) != 2 || commits[0].SHA1 != second || commits[1].SHA1 != third {
+		t.Errorf("expected the commits after the cut, got %v", commits)
+	}
+
+	sha, err := client.ResolveTime(c.dir, c.when)
+
+	if err != nil || sha != third {
+		t.Errorf("expected %s, got %s, %v", third, sha, err)
+	}
+
+	// The commits before the cut are not available.
+	if _, err := client.ResolveTime(c.dir, c.when.Add(-72*time.Hour)); err != ErrUnknownCommit {
+		t.Errorf("expected %v, got %v", ErrUnknownCommit, err)
+	}
+}
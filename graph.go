@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Maximum number of shortest paths returned by default.
+const defaultPathLimit = 10
+
+// JoinStep joins a table to a neighboring table by a reference in either
+// direction. Composite references sharing a name are joined on all fields.
+type JoinStep struct {
+	From       string   `json:"from"`
+	FromFields []string `json:"from_fields"`
+	To         string   `json:"to"`
+	ToFields   []string `json:"to_fields"`
+	Reference  string   `json:"reference"`
+
+	// Inbound is true if the table joined to references the table joined
+	// from.
+	Inbound bool `json:"inbound"`
+}
+
+// Condition returns the join condition of the step.
+func (s *JoinStep) Condition() string {
+	conds := make([]string, len(s.FromFields))
+
+	for i, f := range s.FromFields {
+		conds[i] = fmt.Sprintf("%s.%s = %s.%s", s.From, f, s.To, s.ToFields[i])
+	}
+
+	return strings.Join(conds, " AND ")
+}
+
+func (s *JoinStep) direction() string {
+	if s.Inbound {
+		return "referenced by"
+	}
+
+	return "references"
+}
+
+// TableGraph is the graph of the tables of a model linked by the references
+// of their fields.
+type TableGraph struct {
+	Model *dms.Model
+
+	steps map[string][]*JoinStep
+}
+
+// NewTableGraph builds the graph from the references and inbound references
+// of the fields of the model.
+func NewTableGraph(m *dms.Model) *TableGraph {
+	g := &TableGraph{
+		Model: m,
+		steps: make(map[string][]*JoinStep),
+	}
+
+	for _, t := range m.Tables.List() {
+		k := strings.ToLower(t.Name)
+
+		// Steps by direction, reference name and table to group the fields
+		// of composite references.
+		grouped := make(map[string]*JoinStep)
+
+		add := func(inbound bool, r *dms.Reference, f *dms.Field) {
+			to := r.Field.Table.Name
+			key := fmt.Sprintf("%t %s %s", inbound, r.Name, strings.ToLower(to))
+
+			// Unnamed references are never combined.
+			if s, ok := grouped[key]; ok && r.Name != "" {
+				s.FromFields = append(s.FromFields, f.Name)
+				s.ToFields = append(s.ToFields, r.Field.Name)
+				return
+			}
+
+			s := &JoinStep{
+				From:       t.Name,
+				FromFields: []string{f.Name},
+				To:         to,
+				ToFields:   []string{r.Field.Name},
+				Reference:  r.Name,
+				Inbound:    inbound,
+			}
+
+			grouped[key] = s
+			g.steps[k] = append(g.steps[k], s)
+		}
+
+		for _, f := range t.Fields.List() {
+			if f.References != nil {
+				add(false, f.References, f)
+			}
+
+			for _, r := range f.InboundRefs {
+				add(true, r, f)
+			}
+		}
+
+		sort.Sort(joinStepsByTable(g.steps[k]))
+	}
+
+	return g
+}
+
+type joinStepsByTable []*JoinStep
+
+func (s joinStepsByTable) Len() int { return len(s) }
+func (s joinStepsByTable) Less(i, j int) bool {
+	if s[i].To != s[j].To {
+		return s[i].To < s[j].To
+	}
+
+	if s[i].Inbound != s[j].Inbound {
+		return !s[i].Inbound
+	}
+
+	return s[i].Reference < s[j].Reference
+}
+func (s joinStepsByTable) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// Neighbors returns the joins from the table to the tables it references
+// and the tables referencing it.
+func (g *TableGraph) Neighbors(t *dms.Table) []*JoinStep {
+	return g.steps[strings.ToLower(t.Name)]
+}
+
+// ShortestPaths returns up to limit paths of the fewest joins between two
+// tables. A table is joined to itself by an empty path.
+func (g *TableGraph) ShortestPaths(from, to *dms.Table, limit int) [][]*JoinStep {
+	start := strings.ToLower(from.Name)
+	end := strings.ToLower(to.Name)
+
+	if start == end {
+		return [][]*JoinStep{{}}
+	}
+
+	// Breadth-first search recording every step that reaches a table at its
+	// shortest distance.
+	dist := map[string]int{start: 0}
+	preds := make(map[string][]*JoinStep)
+	frontier := []string{start}
+
+	for len(frontier) > 0 && preds[end] == nil {
+		var next []string
+
+		for _, t := range frontier {
+			for _, s := range g.steps[t] {
+				r := strings.ToLower(s.To)
+
+				if d, ok := dist[r]; ok && d <= dist[t] {
+					continue
+				}
+
+				if _, ok := dist[r]; !ok {
+					dist[r] = dist[t] + 1
+					next = append(next, r)
+				}
+
+				preds[r] = append(preds[r], s)
+			}
+		}
+
+		frontier = next
+	}
+
+	paths := make([][]*JoinStep, 0)
+
+	// Walk back from the end, the steps are reversed into joins order.
+	var walk func(t string, suffix []*JoinStep)
+
+	walk = func(t string, suffix []*JoinStep) {
+		if len(paths) >= limit {
+			return
+		}
+
+		if t == start {
+			path := make([]*JoinStep, len(suffix))
+
+			for i, s := range suffix {
+				path[len(suffix)-1-i] = s
+			}
+
+			paths = append(paths, path)
+			return
+		}
+
+		for _, s := range preds[t] {
+			walk(strings.ToLower(s.From), append(suffix, s))
+		}
+	}
+
+	walk(end, nil)
+
+	return paths
+}
+
+// WriteJoinPathsMarkdown writes each path as the list of joins and a SQL
+// FROM clause.
+func WriteJoinPathsMarkdown(w io.Writer, m *dms.Model, from, to *dms.Table, paths [][]*JoinStep) {
+	fmt.Fprintf(w, "# Joining %s to %s\n\n", from.Name, to.Name)
+	fmt.Fprintf(w, "*[%s](/models/%s)*\n", m, m.URLPath())
+
+	if len(paths) == 0 {
+		fmt.Fprint(w, "\nThe tables are not connected by references.\n")
+		return
+	}
+
+	for i, path := range paths {
+		fmt.Fprintf(w, "\n## Path %d\n\n", i+1)
+
+		for _, s := range path {
+			fmt.Fprintf(w, "- [%s](/models/%s/%s) %s [%s](/models/%s/%s)", s.From, m.URLPath(), s.From, s.direction(), s.To, m.URLPath(), s.To)
+
+			if s.Reference != "" {
+				fmt.Fprintf(w, " by `%s`", s.Reference)
+			}
+
+			fmt.Fprintln(w)
+		}
+
+		if len(path) > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "```sql\nFROM %s\n", from.Name)
+
+		for _, s := range path {
+			fmt.Fprintf(w, "JOIN %s ON %s\n", s.To, s.Condition())
+		}
+
+		fmt.Fprint(w, "```\n")
+	}
+}
+
+// WriteNeighborsMarkdown writes the joins of a table as a Markdown table.
+func WriteNeighborsMarkdown(w io.Writer, m *dms.Model, t *dms.Table, steps []*JoinStep) {
+	fmt.Fprintf(w, "# %s neighbors\n\n", t.Name)
+	fmt.Fprintf(w, "*[%s](/models/%s) / [%s](/models/%s)*\n", m, m.URLPath(), t.Name, t.URLPath())
+
+	if len(steps) == 0 {
+		fmt.Fprint(w, "\nThe table has no references.\n")
+		return
+	}
+
+	fmt.Fprint(w, "\nTable | Direction | Reference | Join\n")
+	fmt.Fprint(w, "----- | --------- | --------- | ----\n")
+
+	for _, s := range steps {
+		fmt.Fprintf(w, "[%s](/models/%s/%s) | %s | %s | `%s`\n", s.To, m.URLPath(), s.To, s.direction(), s.Reference, s.Condition())
+	}
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+	"github.com/graphql-go/graphql"
+)
+
+// The GraphQL types resolve directly against the model cache, including the
+// relationships that are hidden in the JSON representation. Most fields use
+// the default resolver which matches the struct field names, the sets and
+// maps have explicit resolvers. The object types refer to each other so their
+// fields are thunks.
+var (
+	gqlModel     *graphql.Object
+	gqlTable     *graphql.Object
+	gqlField     *graphql.Object
+	gqlReference *graphql.Object
+	gqlMapping   *graphql.Object
+
+	gqlSchema graphql.Schema
+)
+
+var gqlNameArgs = graphql.FieldConfigArgument{
+	"name": &graphql.ArgumentConfig{
+		Type: graphql.NewNonNull(graphql.String),
+	},
+}
+
+func gqlStringArg(p graphql.ResolveParams, n string) string {
+	s, _ := p.Args[n].(string)
+	return s
+}
+
+var gqlRelease = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Release",
+	Fields: graphql.Fields{
+		"level":  &graphql.Field{Type: graphql.String},
+		"serial": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var gqlIssue = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Issue",
+	Description: "A problem found in a definition file of a model.",
+	Fields: graphql.Fields{
+		"severity": &graphql.Field{Type: graphql.String},
+		"rule":     &graphql.Field{Type: graphql.String},
+		"path":     &graphql.Field{Type: graphql.String},
+		"line":     &graphql.Field{Type: graphql.Int},
+		"message":  &graphql.Field{Type: graphql.String},
+	},
+})
+
+var gqlPrimaryKey = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PrimaryKey",
+	Fields: graphql.Fields{
+		"name":   &graphql.Field{Type: graphql.String},
+		"table":  &graphql.Field{Type: graphql.String},
+		"fields": &graphql.Field{Type: graphql.NewList(graphql.String)},
+	},
+})
+
+var gqlUnique = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Unique",
+	Fields: graphql.Fields{
+		"name":   &graphql.Field{Type: graphql.String},
+		"table":  &graphql.Field{Type: graphql.String},
+		"fields": &graphql.Field{Type: graphql.NewList(graphql.String)},
+	},
+})
+
+var gqlForeignKey = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ForeignKey",
+	Fields: graphql.Fields{
+		"name":        &graphql.Field{Type: graphql.String},
+		"sourceTable": &graphql.Field{Type: graphql.String},
+		"sourceField": &graphql.Field{Type: graphql.String},
+		"targetTable": &graphql.Field{Type: graphql.String},
+		"targetField": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var gqlNotNullable = graphql.NewObject(graphql.ObjectConfig{
+	Name: "NotNullable",
+	Fields: graphql.Fields{
+		"table": &graphql.Field{Type: graphql.String},
+		"field": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var gqlIndex = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Index",
+	Fields: graphql.Fields{
+		"name":   &graphql.Field{Type: graphql.String},
+		"unique": &graphql.Field{Type: graphql.Boolean},
+		"order":  &graphql.Field{Type: graphql.String},
+		"table":  &graphql.Field{Type: graphql.String},
+		"fields": &graphql.Field{Type: graphql.NewList(graphql.String)},
+	},
+})
+
+// The constraints and indexes are stored in maps, they are listed by name
+// to keep the responses stable.
+var gqlSchemaType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Schema",
+	Description: "Constraints and indexes of a model.",
+	Fields: graphql.Fields{
+		"primaryKeys": &graphql.Field{
+			Type: graphql.NewList(gqlPrimaryKey),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*dms.Schema).PrimaryKeyList(), nil
+			},
+		},
+		"uniques": &graphql.Field{
+			Type: graphql.NewList(gqlUnique),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*dms.Schema).UniqueList(), nil
+			},
+		},
+		"foreignKeys": &graphql.Field{
+			Type: graphql.NewList(gqlForeignKey),
+		},
+		"notNullables": &graphql.Field{
+			Type: graphql.NewList(gqlNotNullable),
+		},
+		"indexes": &graphql.Field{
+			Type: graphql.NewList(gqlIndex),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*dms.Schema).IndexList(), nil
+			},
+		},
+	},
+})
+
+var gqlRepo = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Repo",
+	Description: "A git repository the models are read from.",
+	Fields: graphql.Fields{
+		"url":     &graphql.Field{Type: graphql.String},
+		"ref":     &graphql.Field{Type: graphql.String},
+		"default": &graphql.Field{Type: graphql.Boolean},
+		"branch": &graphql.Field{
+			Type:              graphql.String,
+			DeprecationReason: "Use ref, the ref may also be a tag.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*Repo).Ref, nil
+			},
+		},
+		"commitSHA1": &graphql.Field{Type: graphql.String},
+		"commitTime": &graphql.Field{Type: graphql.DateTime},
+		"fetchTime":  &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+func init() {
+	gqlModel = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Model",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": &graphql.Field{Type: graphql.String},
+				"version": &graphql.Field{
+					Type:        graphql.String,
+					Description: "The version the model is addressed by, qualified with its ref if it is not of the first ref of its repository.",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*dms.Model).QualifiedVersion(), nil
+					},
+				},
+				"ref": &graphql.Field{
+					Type:        graphql.String,
+					Description: "The branch or tag of the repository the model was read from.",
+				},
+				"label":       &graphql.Field{Type: graphql.String},
+				"description": &graphql.Field{Type: graphql.String},
+				"url":         &graphql.Field{Type: graphql.String},
+				"release":     &graphql.Field{Type: gqlRelease},
+				"tables": &graphql.Field{
+					Type: graphql.NewList(gqlTable),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*dms.Model).Tables.List(), nil
+					},
+				},
+				"table": &graphql.Field{
+					Type: gqlTable,
+					Args: gqlNameArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*dms.Model).Tables.Get(gqlStringArg(p, "name")), nil
+					},
+				},
+				"schema": &graphql.Field{Type: gqlSchemaType},
+				"issues": &graphql.Field{
+					Type: graphql.NewList(gqlIssue),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return sortedIssues(p.Source.(*dms.Model)), nil
+					},
+				},
+			}
+		}),
+	})
+
+	gqlTable = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Table",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name":        &graphql.Field{Type: graphql.String},
+				"label":       &graphql.Field{Type: graphql.String},
+				"description": &graphql.Field{Type: graphql.String},
+				"model":       &graphql.Field{Type: gqlModel},
+				"fields": &graphql.Field{
+					Type: graphql.NewList(gqlField),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*dms.Table).Fields.List(), nil
+					},
+				},
+				"field": &graphql.Field{
+					Type: gqlField,
+					Args: gqlNameArgs,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*dms.Table).Fields.Get(gqlStringArg(p, "name")), nil
+					},
+				},
+				"renamedFrom": &graphql.Field{Type: gqlTable},
+				"renamedTo":   &graphql.Field{Type: gqlTable},
+			}
+		}),
+	})
+
+	gqlField = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Field",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name":        &graphql.Field{Type: graphql.String},
+				"label":       &graphql.Field{Type: graphql.String},
+				"description": &graphql.Field{Type: graphql.String},
+				"required":    &graphql.Field{Type: graphql.Boolean},
+				"type":        &graphql.Field{Type: graphql.String},
+				"length":      &graphql.Field{Type: graphql.Int},
+				"precision":   &graphql.Field{Type: graphql.Int},
+				"scale":       &graphql.Field{Type: graphql.Int},
+				"default":     &graphql.Field{Type: graphql.String},
+				"table":       &graphql.Field{Type: gqlTable},
+				"references":  &graphql.Field{Type: gqlReference},
+				"inboundRefs": &graphql.Field{Type: graphql.NewList(gqlReference)},
+				"mappings":    &graphql.Field{Type: graphql.NewList(gqlMapping)},
+				"renamedFrom": &graphql.Field{Type: gqlField},
+				"renamedTo":   &graphql.Field{Type: gqlField},
+			}
+		}),
+	})
+
+	gqlReference = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Reference",
+		Description: "A reference to a field. Inbound references point to the referencing field.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name":  &graphql.Field{Type: graphql.String},
+				"field": &graphql.Field{Type: gqlField},
+			}
+		}),
+	})
+
+	gqlMapping = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Mapping",
+		Description: "A correspondence to a field of another model.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"comment":  &graphql.Field{Type: graphql.String},
+				"warnings": &graphql.Field{Type: graphql.NewList(graphql.String)},
+				"field":    &graphql.Field{Type: gqlField},
+			}
+		}),
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"repos": &graphql.Field{
+				Type: graphql.NewList(gqlRepo),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return []*Repo(registeredRepos), nil
+				},
+			},
+			"models": &graphql.Field{
+				Type:        graphql.NewList(gqlModel),
+				Description: "All models or the versions of the named model.",
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					n := gqlStringArg(p, "name")
+
+					if n == "" {
+						return dataModelCache.List(), nil
+					}
+
+					var l []*dms.Model
+
+					for _, m := range dataModelCache.List() {
+						if strings.EqualFold(m.Name, n) {
+							l = append(l, m)
+						}
+					}
+
+					return l, nil
+				},
+			},
+			"model": &graphql.Field{
+				Type: gqlModel,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					"version": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return dataModelCache.Get(gqlStringArg(p, "name"), gqlStringArg(p, "version")), nil
+				},
+			},
+		},
+	})
+
+	var err error
+
+	gqlSchema, err = graphql.NewSchema(graphql.SchemaConfig{
+		Query: query,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GraphQLRequest is the body of a GraphQL request.
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// ExecGraphQL executes a request against the model cache.
+func ExecGraphQL(req *GraphQLRequest) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         gqlSchema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+	})
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"container/list"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+var ErrUnknownCommit = errors.New("repo: unknown commit")
+
+// Formats of the dates a commit can be looked up by. A date without a time
+// refers to the end of the day.
+var historyDateFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// Maximum number of commits the models of which are kept.
+const historyCacheSize = 32
+
+// historyEntry is the models of a commit. Done is closed once the models
+// are parsed.
+type historyEntry struct {
+	sha    string
+	models *dms.Models
+	err    error
+	done   chan struct{}
+	elem   *list.Element
+}
+
+// historyCache holds the models parsed at a commit by SHA1, most recently
+// used first. The snapshots never change, the least recently used are
+// dropped along with their files.
+var historyCache = struct {
+	sync.Mutex
+	m    map[string]*historyEntry
+	lru  *list.List
+	init sync.Once
+}{
+	m:   make(map[string]*historyEntry),
+	lru: list.New(),
+}
+
+// parseHistoryDate returns the time of a date or false if the value is not
+// a date.
+func parseHistoryDate(s string) (time.Time, bool) {
+	for _, f := range historyDateFormats {
+		if t, err := time.Parse(f, s); err == nil {
+			if f == "2006-01-02" {
+				t = t.Add(24*time.Hour - time.Second)
+			}
+
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// resolveCommit returns the SHA1 of the commit at a SHA1, possibly
+// abbreviated, or the last commit of the checked out ref at a date.
+func (r *Repo) resolveCommit(at string) (string, error) {
+	if t, ok := parseHistoryDate(at); ok {
+		return r.client.ResolveTime(r.path, t)
+	}
+
+	return r.client.Resolve(r.path, at)
+}
+
+// historyDir returns the directory the files of a commit are extracted to.
+func historyDir(sha string) string {
+	return filepath.Join(reposDir, ".history", sha)
+}
+
+// definitionDirs returns the directories of the checkout with definitions
+// files relative to it.
+func definitionDirs(root string) []string {
+	var dirs []string
+
+	seen := make(map[string]bool)
+
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		// Ignore errors.
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) != ".csv" {
+			return nil
+		}
+
+		dir, err := filepath.Rel(root, filepath.Dir(path))
+
+		if err != nil || seen[dir] {
+			return nil
+		}
+
+		f, err := os.Open(path)
+
+		if err != nil {
+			return nil
+		}
+
+		defer f.Close()
+
+		if detectFileType(NewMapCSVReader(f).Fields()) != UnknownType {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+
+		return nil
+	})
+
+	return dirs
+}
+
+// buildModelsAt extracts the definitions files of the commit into the
+// history directory and parses them. Only the directories with definitions
+// files in the checkout are extracted.
+func (r *Repo) buildModelsAt(sha string) (*dms.Models, error) {
+	r.Lock()
+	paths := definitionDirs(r.path)
+	r.Unlock()
+
+	dir := historyDir(sha)
+
+	// Extract into a temporary directory so a failed extraction is not
+	// mistaken for a snapshot.
+	tmp := dir + ".tmp"
+
+	os.RemoveAll(tmp)
+	os.RemoveAll(dir)
+
+	if err := r.client.Extract(r.path, sha, tmp, paths...); err != nil {
+		os.RemoveAll(tmp)
+		return nil, err
+	}
+
+	if err := os.Rename(tmp, dir); err != nil {
+		os.RemoveAll(tmp)
+		return nil, err
+	}
+
+	models := loadModels(dir)
+
+	for _, m := range models.List() {
+		m.Ref = sha
+		m.Commit = sha
+	}
+
+	return models, nil
+}
+
+// modelsAt returns the models of the repo as of the commit. The models of
+// a commit are built once, concurrent requests for the same commit wait for
+// the build.
+func (r *Repo) modelsAt(sha string) (*dms.Models, error) {
+	// Snapshots of previous runs may have been extracted differently.
+	historyCache.init.Do(func() {
+		os.RemoveAll(filepath.Join(reposDir, ".history"))
+	})
+
+	historyCache.Lock()
+
+	e, ok := historyCache.m[sha]
+
+	if ok {
+		if e.elem != nil {
+			historyCache.lru.MoveToFront(e.elem)
+		}
+	} else {
+		e = &historyEntry{
+			sha:  sha,
+			done: make(chan struct{}),
+		}
+
+		historyCache.m[sha] = e
+	}
+
+	historyCache.Unlock()
+
+	if ok {
+		<-e.done
+		return e.models, e.err
+	}
+
+	e.models, e.err = r.buildModelsAt(sha)
+
+	close(e.done)
+
+	historyCache.Lock()
+	defer historyCache.Unlock()
+
+	// Failed builds are retried by the next request.
+	if e.err != nil {
+		delete(historyCache.m, sha)
+		return nil, e.err
+	}
+
+	e.elem = historyCache.lru.PushFront(e)
+
+	for historyCache.lru.Len() > historyCacheSize {
+		old := historyCache.lru.Remove(historyCache.lru.Back()).(*historyEntry)
+
+		delete(historyCache.m, old.sha)
+		os.RemoveAll(historyDir(old.sha))
+	}
+
+	return e.models, nil
+}
+
+// historyModel returns the model as it was at a commit SHA1 or date. The
+// repos are searched in order of registration. Nil is returned if no repo
+// has the model at the commit and an error if no repo has the commit.
+func historyModel(n, v, at string) (*dms.Model, error) {
+	err := ErrUnknownCommit
+
+	for _, r := range registeredRepos {
+		if !r.git {
+			continue
+		}
+
+		r.Lock()
+		sha, rerr := r.resolveCommit(at)
+		r.Unlock()
+
+		if rerr != nil {
+			continue
+		}
+
+		var models *dms.Models
+
+		if models, err = r.modelsAt(sha); err != nil {
+			return nil, err
+		}
+
+		if m := models.Get(n, v); m != nil {
+			return m, nil
+		}
+	}
+
+	return nil, err
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/json"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"mime"
 	"net/http"
+	"strconv"
 	"strings"
 
 	dms "github.com/chop-dbhi/data-models-service/client"
@@ -21,6 +23,8 @@ var (
 		"text/markdown":    "markdown",
 		"text/html":        "html",
 		"application/json": "json",
+		"application/sql":  "sql",
+		"text/csv":         "csv",
 	}
 
 	queryFormats = map[string]string{
@@ -28,6 +32,8 @@ var (
 		"markdown": "markdown",
 		"html":     "html",
 		"json":     "json",
+		"sql":      "sql",
+		"csv":      "csv",
 	}
 
 	userAgent = "DataModelsService/%s (+https://github.com/chop-dbhi/data-models-service)"
@@ -47,22 +53,29 @@ func jsonResponse(w http.ResponseWriter, d interface{}) error {
 // detectFormat applies content negotiation logic to determine the
 // appropriate response representation.
 func detectFormat(w http.ResponseWriter, r *http.Request) string {
-	var (
-		ok     bool
-		format string
-	)
+	return detectFormatOr(w, r, defaultFormat)
+}
 
-	format = queryFormats[strings.ToLower(r.URL.Query().Get("format"))]
+// detectFormatOr is detectFormat with the format of requests that name
+// none.
+func detectFormatOr(w http.ResponseWriter, r *http.Request, def string) string {
+	format := queryFormats[strings.ToLower(r.URL.Query().Get("format"))]
 
 	// Query parameter
 	if format == "" {
-		// Accept header
-		acceptType := r.Header.Get("Accept")
-		acceptType, _, _ = mime.ParseMediaType(acceptType)
+		// Accept header, the first known type of the list is used.
+		for _, acceptType := range strings.Split(r.Header.Get("Accept"), ",") {
+			acceptType, _, _ = mime.ParseMediaType(acceptType)
+
+			if f, ok := mimetypes[acceptType]; ok {
+				format = f
+				break
+			}
+		}
 
 		// Fallback to default
-		if format, ok = mimetypes[acceptType]; !ok {
-			format = defaultFormat
+		if format == "" {
+			format = def
 		}
 	}
 
@@ -75,6 +88,10 @@ func detectFormat(w http.ResponseWriter, r *http.Request) string {
 		contentType = "text/markdown; charset=utf-8"
 	case "json":
 		contentType = "application/json; charset=utf-8"
+	case "sql":
+		contentType = "text/plain; charset=utf-8"
+	case "csv":
+		contentType = "text/csv; charset=utf-8"
 	}
 
 	w.Header().Set("user-agent", fmt.Sprintf(userAgent, progVersion))
@@ -83,6 +100,94 @@ func detectFormat(w http.ResponseWriter, r *http.Request) string {
 	return format
 }
 
+// queryDialect returns the SQL dialect named by the dialect query parameter.
+// If the dialect is not registered a bad request response is written and
+// nil is returned.
+func queryDialect(w http.ResponseWriter, r *http.Request) Dialect {
+	n := r.URL.Query().Get("dialect")
+
+	if n == "" {
+		n = defaultDialect
+	}
+
+	d := GetDialect(n)
+
+	if d == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "unknown dialect %q; supported dialects: %s\n", n, strings.Join(DialectNames(), ", "))
+	}
+
+	return d
+}
+
+// queryModelAt returns the model as of the commit SHA1 or date of the
+// parameter, or the current model if the parameter is not set. If the
+// commit is unknown a bad request response is written, if the model is not
+// found a not found response, and false is returned.
+func queryModelAt(w http.ResponseWriter, r *http.Request, n, v, param string) (*dms.Model, bool) {
+	at := r.URL.Query().Get(param)
+
+	if at == "" {
+		if m := dataModelCache.Get(n, v); m != nil {
+			return m, true
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	m, err := historyModel(n, v, at)
+
+	switch {
+	case err == ErrUnknownCommit:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "unknown commit or date %q\n", at)
+		return nil, false
+	case err != nil:
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "error reading models at %q: %s\n", at, err)
+		return nil, false
+	case m == nil:
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return m, true
+}
+
+// queryRenames returns the rename mode of the request. If the mode is
+// unknown a bad request response is written and false is returned.
+func queryRenames(w http.ResponseWriter, r *http.Request) (RenameMode, bool) {
+	n := r.URL.Query().Get("renames")
+
+	if n == "" {
+		return defaultRenameMode, true
+	}
+
+	mode, ok := renameModes[strings.ToLower(n)]
+
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "unknown renames mode %q; supported modes: off, suggest, apply\n", n)
+	}
+
+	return mode, ok
+}
+
+// queryExpand returns the links to expand in the JSON representation. If a
+// link is unknown a bad request response is written and false is returned.
+func queryExpand(w http.ResponseWriter, r *http.Request) (dms.Expand, bool) {
+	e, err := dms.ParseExpand(r.URL.Query().Get("expand"))
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return e, false
+	}
+
+	return e, true
+}
+
 func httpIndex(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	switch detectFormat(w, r) {
 	case "html":
@@ -109,7 +214,17 @@ func httpModels(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	case "html":
 		RenderModelsHTML(w, data)
 	case "json":
-		jsonResponse(w, data["Items"])
+		e, ok := queryExpand(w, r)
+
+		if !ok {
+			return
+		}
+
+		if e.Empty() {
+			jsonResponse(w, data["Items"])
+		} else {
+			jsonResponse(w, expandModels(dataModelCache.List(), e))
+		}
 	default:
 		w.WriteHeader(http.StatusNotAcceptable)
 	}
@@ -135,6 +250,43 @@ func httpModel(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		RenderModelMarkdown(w, data)
 	case "html":
 		RenderModelHTML(w, data)
+	case "json":
+		e, ok := queryExpand(w, r)
+
+		if !ok {
+			return
+		}
+
+		if e.Empty() {
+			jsonResponse(w, m)
+		} else {
+			jsonResponse(w, expandModels(m, e))
+		}
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpHistory(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	if r.URL.Query().Get("at") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "the at parameter is required\n")
+		return
+	}
+
+	m, ok := queryModelAt(w, r, p.ByName("name"), p.ByName("version"), "at")
+
+	if !ok {
+		return
+	}
+
+	switch detectFormat(w, r) {
+	case "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderModelVersionMarkdown(w, m)
+	case "html":
+		w.Header().Set("content-type", "text/html")
+		RenderModelVersionHTML(w, m)
 	case "json":
 		jsonResponse(w, m)
 	default:
@@ -161,12 +313,40 @@ func httpModelVersion(w http.ResponseWriter, r *http.Request, p httprouter.Param
 		w.Header().Set("content-type", "text/html")
 		RenderModelVersionHTML(w, m)
 	case "json":
-		jsonResponse(w, m)
+		e, ok := queryExpand(w, r)
+
+		if !ok {
+			return
+		}
+
+		if e.Empty() {
+			jsonResponse(w, m)
+		} else {
+			jsonResponse(w, expandModel(m, e))
+		}
 	default:
 		w.WriteHeader(http.StatusNotAcceptable)
 	}
 }
 
+// Resources of a model version share the path segment with the table name
+// so they are dispatched by httpTable rather than registered with the router.
+// A table of the same name takes precedence over the resource.
+var modelVersionResources = map[string]httprouter.Handle{
+	"ddl":    httpModelDDL,
+	"erd":    httpModelERD,
+	"issues": httpModelIssues,
+}
+
+// Nested resources of a model version are dispatched by httpField unless
+// the model has a table and field of the same names.
+var modelVersionSubresources = map[string]map[string]httprouter.Handle{
+	"graph": {
+		"path":      httpGraphPath,
+		"neighbors": httpGraphNeighbors,
+	},
+}
+
 func httpTable(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	n := p.ByName("name")
 	v := p.ByName("version")
@@ -183,13 +363,159 @@ func httpTable(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 	}
 
 	if t = m.Tables.Get(tn); t == nil {
+		if h, ok := modelVersionResources[tn]; ok {
+			h(w, r, p)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// JSON is served unless another format is asked for.
+	switch detectFormatOr(w, r, "json") {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderTableMarkdown(w, t)
+	case "html":
+		w.Header().Set("content-type", "text/html")
+		RenderTableHTML(w, t)
+	case "json":
+		e, ok := queryExpand(w, r)
+
+		if !ok {
+			return
+		}
+
+		if e.Empty() {
+			jsonResponse(w, t)
+		} else {
+			jsonResponse(w, expandTable(t, e))
+		}
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpModelDDL(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	n := p.ByName("name")
+	v := p.ByName("version")
+
+	m := dataModelCache.Get(n, v)
+
+	if m == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	d := queryDialect(w, r)
+
+	if d == nil {
+		return
+	}
+
+	w.Header().Set("content-type", "text/plain; charset=utf-8")
+	RenderModelVersionDDL(w, m, d)
+}
+
+func httpModelERD(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	n := p.ByName("name")
+	v := p.ByName("version")
+
+	m := dataModelCache.Get(n, v)
+
+	if m == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	params := r.URL.Query()
+
+	opts := &ERDOptions{
+		Around: params.Get("table"),
+		Hops:   1,
+	}
+
+	if s := params.Get("tables"); s != "" {
+		for _, t := range strings.Split(s, ",") {
+			opts.Tables = append(opts.Tables, strings.TrimSpace(t))
+		}
+	}
+
+	if s := params.Get("hops"); s != "" {
+		hops, err := strconv.Atoi(s)
+
+		if err != nil || hops < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid hops %q\n", s)
+			return
+		}
+
+		opts.Hops = hops
+	}
+
+	e, err := NewERD(m, opts)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return
+	}
+
+	// The diagram languages are plain text formats of this resource only.
+	f := strings.ToLower(params.Get("format"))
+
+	if write, ok := erdWriters[f]; ok {
+		if f == "dot" {
+			w.Header().Set("content-type", "text/vnd.graphviz; charset=utf-8")
+		} else {
+			w.Header().Set("content-type", "text/plain; charset=utf-8")
+		}
+
+		write(e, w)
+		return
+	}
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderERDMarkdown(w, e)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderERDHTML(w, e)
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+		fmt.Fprintf(w, "supported formats: %s, html, markdown\n", strings.Join(ERDFormats(), ", "))
+	}
+}
+
+func httpModelIssues(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	n := p.ByName("name")
+	v := p.ByName("version")
+
+	m := dataModelCache.Get(n, v)
+
+	if m == nil {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
 
 	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderModelIssuesMarkdown(w, m)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderModelIssuesHTML(w, m)
 	case "json":
-		jsonResponse(w, t)
+		issues := sortedIssues(m)
+
+		jsonResponse(w, map[string]interface{}{
+			"model":   m.Name,
+			"version": m.QualifiedVersion(),
+			"counts":  issueCounts(issues),
+			"issues":  issues,
+		})
 	default:
 		w.WriteHeader(http.StatusNotAcceptable)
 	}
@@ -212,39 +538,313 @@ func httpField(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		return
 	}
 
-	if t = m.Tables.Get(tn); t == nil {
+	if t = m.Tables.Get(tn); t == nil || t.Fields.Get(fn) == nil {
+		if h, ok := modelVersionSubresources[tn][fn]; ok {
+			h(w, r, p)
+			return
+		}
+	}
+
+	if t == nil {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
 
 	if f = t.Fields.Get(fn); f == nil {
+		// The history of the table shares the path segment with the
+		// field name.
+		if fn == "history" {
+			h, err := TableHistory(t)
+			renderAttrHistory(w, r, h, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// JSON is served unless another format is asked for.
+	switch detectFormatOr(w, r, "json") {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderFieldMarkdown(w, f)
+	case "html":
+		w.Header().Set("content-type", "text/html")
+		RenderFieldHTML(w, f)
+	case "json":
+		e, ok := queryExpand(w, r)
+
+		if !ok {
+			return
+		}
+
+		if e.Empty() {
+			jsonResponse(w, f)
+		} else {
+			jsonResponse(w, expandField(f, e))
+		}
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpFieldHistory(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	var (
+		m *dms.Model
+		t *dms.Table
+		f *dms.Field
+	)
+
+	if m = dataModelCache.Get(p.ByName("name"), p.ByName("version")); m == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if t = m.Tables.Get(p.ByName("table")); t == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if f = t.Fields.Get(p.ByName("field")); f == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	h, err := FieldHistory(f)
+	renderAttrHistory(w, r, h, err)
+}
+
+// renderAttrHistory writes the history of a table or field. Models that are
+// not read from a git repo have no history.
+func renderAttrHistory(w http.ResponseWriter, r *http.Request, h *AttrHistory, err error) {
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "error reading history: %s\n", err)
+		return
+	}
+
+	if h == nil {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
 
 	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderAttrHistoryMarkdown(w, h)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderAttrHistoryHTML(w, h)
 	case "json":
-		jsonResponse(w, f)
+		jsonResponse(w, h)
 	default:
 		w.WriteHeader(http.StatusNotAcceptable)
 	}
 }
 
-func httpCompareModels(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
-	n1 := p.ByName("name1")
-	v1 := p.ByName("version1")
+// queryTable returns the table of the model named by a query parameter. If
+// the table is unknown a bad request response is written and nil is returned.
+func queryTable(w http.ResponseWriter, r *http.Request, m *dms.Model, param string) *dms.Table {
+	n := r.URL.Query().Get(param)
 
-	m1 := dataModelCache.Get(n1, v1)
+	if n == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "%s parameter is required\n", param)
+		return nil
+	}
 
-	if m1 == nil {
+	t := m.Tables.Get(n)
+
+	if t == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "unknown table %q\n", n)
+	}
+
+	return t
+}
+
+func httpGraphPath(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	n := p.ByName("name")
+	v := p.ByName("version")
+
+	m := dataModelCache.Get(n, v)
+
+	if m == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	from := queryTable(w, r, m, "from")
+
+	if from == nil {
+		return
+	}
+
+	to := queryTable(w, r, m, "to")
+
+	if to == nil {
+		return
+	}
+
+	limit := defaultPathLimit
+
+	if s := r.URL.Query().Get("limit"); s != "" {
+		var err error
+
+		if limit, err = strconv.Atoi(s); err != nil || limit < 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid limit %q\n", s)
+			return
+		}
+	}
+
+	paths := NewTableGraph(m).ShortestPaths(from, to, limit)
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderJoinPathsMarkdown(w, m, from, to, paths)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderJoinPathsHTML(w, m, from, to, paths)
+	case "json":
+		length := -1
+
+		if len(paths) > 0 {
+			length = len(paths[0])
+		}
+
+		jsonResponse(w, map[string]interface{}{
+			"model":   m.Name,
+			"version": m.QualifiedVersion(),
+			"from":    from.Name,
+			"to":      to.Name,
+			"length":  length,
+			"paths":   paths,
+		})
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpGraphNeighbors(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	n := p.ByName("name")
+	v := p.ByName("version")
+
+	m := dataModelCache.Get(n, v)
+
+	if m == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	t := queryTable(w, r, m, "table")
+
+	if t == nil {
+		return
+	}
+
+	steps := NewTableGraph(m).Neighbors(t)
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderNeighborsMarkdown(w, m, t, steps)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderNeighborsHTML(w, m, t, steps)
+	case "json":
+		if steps == nil {
+			steps = []*JoinStep{}
+		}
+
+		jsonResponse(w, map[string]interface{}{
+			"model":     m.Name,
+			"version":   m.QualifiedVersion(),
+			"table":     t.Name,
+			"neighbors": steps,
+		})
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpLineage(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	var (
+		m *dms.Model
+		t *dms.Table
+		f *dms.Field
+	)
+
+	if m = dataModelCache.Get(p.ByName("name"), p.ByName("version")); m == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if t = m.Tables.Get(p.ByName("table")); t == nil {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
 
-	n2 := p.ByName("name2")
-	v2 := p.ByName("version2")
+	if f = t.Fields.Get(p.ByName("field")); f == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	params := r.URL.Query()
+
+	var depth int
+
+	if s := params.Get("depth"); s != "" {
+		var err error
+
+		if depth, err = strconv.Atoi(s); err != nil || depth < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid depth %q\n", s)
+			return
+		}
+	}
+
+	l := TraceLineage(f, depth)
+
+	// The diagram languages are plain text formats of this resource only.
+	format := strings.ToLower(params.Get("format"))
+
+	if write, ok := lineageWriters[format]; ok {
+		if format == "dot" {
+			w.Header().Set("content-type", "text/vnd.graphviz; charset=utf-8")
+		} else {
+			w.Header().Set("content-type", "text/plain; charset=utf-8")
+		}
 
-	m2 := dataModelCache.Get(n2, v2)
+		write(l, w)
+		return
+	}
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderLineageMarkdown(w, l)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderLineageHTML(w, l)
+	case "json":
+		jsonResponse(w, l)
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+		fmt.Fprintf(w, "supported formats: %s, html, json, markdown\n", strings.Join(LineageFormats(), ", "))
+	}
+}
+
+func httpMappings(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	m1 := dataModelCache.Get(p.ByName("name1"), p.ByName("version1"))
+
+	if m1 == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	m2 := dataModelCache.Get(p.ByName("name2"), p.ByName("version2"))
 
 	if m2 == nil {
 		w.WriteHeader(http.StatusNotFound)
@@ -254,15 +854,201 @@ func httpCompareModels(w http.ResponseWriter, r *http.Request, p httprouter.Para
 	switch detectFormat(w, r) {
 	case "md", "markdown":
 		w.Header().Set("content-type", "text/markdown")
-		RenderModelCompareMarkdown(w, m1, m2)
+		RenderMappingCoverageMarkdown(w, NewMappingCoverage(m1, m2))
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderMappingCoverageHTML(w, NewMappingCoverage(m1, m2))
+	case "json":
+		jsonResponse(w, NewMappingCoverage(m1, m2))
+	case "csv":
+		RenderMappingCoverageCSV(w, NewMappingCoverage(m1, m2))
+	case "sql":
+		if d := queryDialect(w, r); d != nil {
+			RenderMappingETLSQL(w, m1, m2, d)
+		}
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpCompareModels(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	m1, ok := queryModelAt(w, r, p.ByName("name1"), p.ByName("version1"), "at1")
+
+	if !ok {
+		return
+	}
+
+	m2, ok := queryModelAt(w, r, p.ByName("name2"), p.ByName("version2"), "at2")
+
+	if !ok {
+		return
+	}
+
+	renames, ok := queryRenames(w, r)
+
+	if !ok {
+		return
+	}
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderModelCompareMarkdown(w, DiffModels(m1, m2, renames))
 	case "", "html":
 		w.Header().Set("content-type", "text/html")
-		RenderModelCompareHTML(w, m1, m2)
+		RenderModelCompareHTML(w, DiffModels(m1, m2, renames))
+	case "json":
+		jsonResponse(w, DiffModels(m1, m2, renames))
+	case "sql":
+		if d := queryDialect(w, r); d != nil {
+			RenderModelCompareSQL(w, DiffModels(m1, m2, renames), d)
+		}
 	default:
 		w.WriteHeader(http.StatusNotAcceptable)
 	}
 }
 
+func httpSearch(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	params := r.URL.Query()
+
+	q := &SearchQuery{
+		Text:    params.Get("q"),
+		Model:   params.Get("model"),
+		Version: params.Get("version"),
+		Kind:    strings.ToLower(params.Get("kind")),
+		Limit:   defaultSearchLimit,
+	}
+
+	switch q.Kind {
+	case "", searchModel, searchTable, searchField:
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "unknown kind %q; supported kinds: model, table, field\n", q.Kind)
+		return
+	}
+
+	if l := params.Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+
+		if err != nil || n < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid limit %q\n", l)
+			return
+		}
+
+		q.Limit = n
+	}
+
+	results, total := searchIndex.Search(q)
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderSearchMarkdown(w, q, results, total)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderSearchHTML(w, q, results, total)
+	case "json":
+		jsonResponse(w, map[string]interface{}{
+			"query":   q.Text,
+			"total":   total,
+			"results": results,
+		})
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+func httpQuery(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var (
+		q    string
+		node FieldQuery
+		err  error
+	)
+
+	// A POST request passes the query as a JSON filter.
+	if r.Method == "POST" {
+		defer r.Body.Close()
+
+		var body bytes.Buffer
+
+		node, err = ParseFieldFilter(io.TeeReader(r.Body, &body))
+
+		// The filter is shown on one line.
+		var buf bytes.Buffer
+
+		if json.Compact(&buf, body.Bytes()) == nil {
+			q = buf.String()
+		}
+	} else {
+		q = r.URL.Query().Get("q")
+		node, err = ParseFieldQuery(q)
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return
+	}
+
+	fields := QueryFields(dataModelCache, node)
+
+	switch detectFormat(w, r) {
+	case "md", "markdown":
+		w.Header().Set("content-type", "text/markdown")
+		RenderFieldQueryMarkdown(w, q, fields)
+	case "", "html":
+		w.Header().Set("content-type", "text/html")
+		RenderFieldQueryHTML(w, q, fields)
+	case "json":
+		jsonResponse(w, map[string]interface{}{
+			"query":   q,
+			"total":   len(fields),
+			"results": fieldQueryResults(fields),
+		})
+	default:
+		w.WriteHeader(http.StatusNotAcceptable)
+	}
+}
+
+// httpGraphQL executes a GraphQL query passed in the query string of a GET
+// request or as the JSON body of a POST request.
+func httpGraphQL(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var req GraphQLRequest
+
+	if r.Method == "POST" {
+		defer r.Body.Close()
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "error decoding request: %s\n", err)
+			return
+		}
+	} else {
+		params := r.URL.Query()
+
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+
+		if v := params.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "error decoding variables: %s\n", err)
+				return
+			}
+		}
+	}
+
+	if req.Query == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, "query is required")
+		return
+	}
+
+	w.Header().Set("content-type", "application/json")
+	jsonResponse(w, ExecGraphQL(&req))
+}
+
 func verifyGithubSignature(sig string, r io.Reader) bool {
 	mac := hmac.New(sha1.New, []byte(secret))
 	io.Copy(mac, r)
@@ -308,7 +1094,7 @@ func httpModelSchema(w http.ResponseWriter, r *http.Request, p httprouter.Params
 	aux := make(map[string]interface{})
 
 	aux["model"] = m.Name
-	aux["version"] = m.Version
+	aux["version"] = m.QualifiedVersion()
 	aux["tables"] = m.Tables
 	aux["schema"] = m.Schema
 
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+	"github.com/sirupsen/logrus"
+)
+
+// Severities of issues.
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+	severityInfo    = "info"
+)
+
+// Rule ids of issues.
+const (
+	ruleUnknownFileType  = "unknown-file-type"
+	ruleUnreadableFile   = "unreadable-file"
+	ruleEmptyFile        = "empty-file"
+	ruleInvalidLength    = "invalid-length"
+	ruleInvalidPrecision = "invalid-precision"
+	ruleInvalidScale     = "invalid-scale"
+	ruleUndeclaredTable  = "undeclared-table"
+	ruleDanglingRef      = "dangling-reference"
+	ruleIncompleteMap    = "incomplete-mapping"
+	ruleDanglingMap      = "dangling-mapping"
+	ruleLossyMap         = "lossy-mapping"
+	ruleDanglingRename   = "dangling-rename"
+	ruleShadowedResource = "shadowed-resource"
+)
+
+// Rules of the schema attributes which must be integers.
+var sizeRules = []struct {
+	attr string
+	rule string
+}{
+	{"length", ruleInvalidLength},
+	{"precision", ruleInvalidPrecision},
+	{"scale", ruleInvalidScale},
+}
+
+// addIssue logs an issue and records it on the model if one is given. The
+// path is stored relative to the model directory.
+func addIssue(m *dms.Model, severity, rule, path string, line int, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+
+	loc := path
+
+	if line > 0 {
+		loc = fmt.Sprintf("%s:%d", path, line)
+	}
+
+	switch severity {
+	case severityError:
+		logrus.Errorf("%s (%s): %s", rule, loc, msg)
+	case severityWarning:
+		logrus.Warnf("%s (%s): %s", rule, loc, msg)
+	default:
+		logrus.Infof("%s (%s): %s", rule, loc, msg)
+	}
+
+	if m == nil {
+		return
+	}
+
+	if rel, err := filepath.Rel(m.Path, path); err == nil {
+		path = rel
+	}
+
+	m.Issues = append(m.Issues, &dms.Issue{
+		Severity: severity,
+		Rule:     rule,
+		Path:     path,
+		Line:     line,
+		Message:  msg,
+	})
+}
+
+type issuesByLocation []*dms.Issue
+
+func (s issuesByLocation) Len() int { return len(s) }
+func (s issuesByLocation) Less(i, j int) bool {
+	if s[i].Path == s[j].Path {
+		return s[i].Line < s[j].Line
+	}
+
+	return s[i].Path < s[j].Path
+}
+func (s issuesByLocation) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// sortedIssues returns the issues of the model ordered by file and line.
+func sortedIssues(m *dms.Model) []*dms.Issue {
+	issues := make([]*dms.Issue, len(m.Issues))
+	copy(issues, m.Issues)
+
+	sort.Stable(issuesByLocation(issues))
+
+	return issues
+}
+
+// issueCounts returns the number of issues by severity.
+func issueCounts(issues []*dms.Issue) map[string]int {
+	counts := map[string]int{
+		severityError:   0,
+		severityWarning: 0,
+		severityInfo:    0,
+	}
+
+	for _, i := range issues {
+		counts[i.Severity]++
+	}
+
+	return counts
+}
+
+// WriteIssuesMarkdown writes the issues of a model as a Markdown table.
+func WriteIssuesMarkdown(w io.Writer, m *dms.Model) {
+	issues := sortedIssues(m)
+	counts := issueCounts(issues)
+
+	fmt.Fprintf(w, "# %s issues\n\n", m)
+
+	fmt.Fprintf(w, "%d errors, %d warnings, %d info\n", counts[severityError], counts[severityWarning], counts[severityInfo])
+
+	if len(issues) == 0 {
+		return
+	}
+
+	fmt.Fprint(w, "\nSeverity | Rule | Location | Message\n")
+	fmt.Fprint(w, "-------- | ---- | -------- | -------\n")
+
+	for _, i := range issues {
+		loc := i.Path
+
+		if i.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", i.Path, i.Line)
+		}
+
+		fmt.Fprintf(w, "%s | `%s` | `%s` | %s\n", i.Severity, i.Rule, loc, i.Message)
+	}
+}
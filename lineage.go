@@ -0,0 +1,260 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// LineageNode is a field reached from the root of a lineage by following
+// mappings. The depth is the number of mappings followed.
+type LineageNode struct {
+	dms.FieldID
+	Depth int `json:"depth"`
+}
+
+// LineageEdge is a mapping between two fields of a lineage. Mappings are not
+// directed, the edge points away from the root.
+type LineageEdge struct {
+	From    dms.FieldID `json:"from"`
+	To      dms.FieldID `json:"to"`
+	Comment string      `json:"comment"`
+}
+
+// Lineage is the graph of the fields transitively mapped to a field across
+// models and versions.
+type Lineage struct {
+	Root  dms.FieldID    `json:"root"`
+	Nodes []*LineageNode `json:"nodes"`
+	Edges []*LineageEdge `json:"edges"`
+}
+
+// TraceLineage walks the mappings of the field breadth-first. Each field is
+// visited once so cycles of mappings terminate. If max depth is greater than
+// zero the mappings of fields at that depth are not followed.
+func TraceLineage(f *dms.Field, maxDepth int) *Lineage {
+	root := f.ID()
+
+	l := &Lineage{
+		Root:  root,
+		Nodes: []*LineageNode{{FieldID: root}},
+		Edges: make([]*LineageEdge, 0),
+	}
+
+	depths := map[dms.FieldID]int{root: 0}
+	edges := make(map[string]bool)
+
+	queue := []*dms.Field{f}
+
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+
+		uid := u.ID()
+		depth := depths[uid]
+
+		if maxDepth > 0 && depth >= maxDepth {
+			continue
+		}
+
+		for _, mp := range u.Mappings {
+			vid := mp.Field.ID()
+
+			// Both fields of a mapping hold it, the edge is only added
+			// when it is first seen.
+			a, b := uid.String(), vid.String()
+
+			if b < a {
+				a, b = b, a
+			}
+
+			key := a + " " + b + " " + mp.Comment
+
+			if edges[key] {
+				continue
+			}
+
+			edges[key] = true
+
+			l.Edges = append(l.Edges, &LineageEdge{
+				From:    uid,
+				To:      vid,
+				Comment: mp.Comment,
+			})
+
+			if _, ok := depths[vid]; ok {
+				continue
+			}
+
+			depths[vid] = depth + 1
+
+			l.Nodes = append(l.Nodes, &LineageNode{
+				FieldID: vid,
+				Depth:   depth + 1,
+			})
+
+			queue = append(queue, mp.Field)
+		}
+	}
+
+	return l
+}
+
+// modelVersions returns the model versions of the nodes in order of first
+// appearance with the nodes of each.
+func (l *Lineage) modelVersions() ([]string, map[string][]*LineageNode) {
+	var keys []string
+
+	groups := make(map[string][]*LineageNode)
+
+	for _, n := range l.Nodes {
+		k := n.Model + "/" + n.Version
+
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+
+		groups[k] = append(groups[k], n)
+	}
+
+	return keys, groups
+}
+
+// nodeIDs assigns a short identifier to each node for the diagram languages.
+func (l *Lineage) nodeIDs() map[dms.FieldID]string {
+	ids := make(map[dms.FieldID]string, len(l.Nodes))
+
+	for i, n := range l.Nodes {
+		ids[n.FieldID] = fmt.Sprintf("n%d", i)
+	}
+
+	return ids
+}
+
+// WriteDot writes the lineage in the Graphviz DOT language with a cluster
+// per model version.
+func (l *Lineage) WriteDot(w io.Writer) {
+	ids := l.nodeIDs()
+	keys, groups := l.modelVersions()
+
+	fmt.Fprintf(w, "digraph %q {\n", "Lineage of "+l.Root.String())
+	fmt.Fprint(w, "  rankdir=LR;\n")
+	fmt.Fprint(w, "  node [shape=box, fontname=\"Helvetica\", fontsize=10];\n")
+	fmt.Fprint(w, "  edge [fontname=\"Helvetica\", fontsize=9];\n")
+
+	for i, k := range keys {
+		fmt.Fprintf(w, "\n  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(w, "    label=%q;\n", k)
+
+		for _, n := range groups[k] {
+			style := ""
+
+			if n.FieldID == l.Root {
+				style = ", style=bold"
+			}
+
+			fmt.Fprintf(w, "    %s [label=%q%s];\n", ids[n.FieldID], n.Table+"."+n.Field, style)
+		}
+
+		fmt.Fprint(w, "  }\n")
+	}
+
+	if len(l.Edges) > 0 {
+		fmt.Fprintln(w)
+	}
+
+	for _, e := range l.Edges {
+		fmt.Fprintf(w, "  %s -> %s [label=%q];\n", ids[e.From], ids[e.To], e.Comment)
+	}
+
+	fmt.Fprint(w, "}\n")
+}
+
+// mermaidText escapes quotes and line breaks in the text of a Mermaid label.
+func mermaidText(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", " ").Replace(s)
+}
+
+// WriteMermaid writes the lineage as a Mermaid flowchart with a subgraph per
+// model version.
+func (l *Lineage) WriteMermaid(w io.Writer) {
+	ids := l.nodeIDs()
+	keys, groups := l.modelVersions()
+
+	fmt.Fprint(w, "flowchart LR\n")
+
+	for i, k := range keys {
+		fmt.Fprintf(w, "  subgraph m%d [\"%s\"]\n", i, mermaidText(k))
+
+		for _, n := range groups[k] {
+			fmt.Fprintf(w, "    %s[\"%s\"]\n", ids[n.FieldID], mermaidText(n.Table+"."+n.Field))
+		}
+
+		fmt.Fprint(w, "  end\n")
+	}
+
+	for _, e := range l.Edges {
+		if e.Comment == "" {
+			fmt.Fprintf(w, "  %s --> %s\n", ids[e.From], ids[e.To])
+		} else {
+			fmt.Fprintf(w, "  %s -->|\"%s\"| %s\n", ids[e.From], mermaidText(e.Comment), ids[e.To])
+		}
+	}
+
+	fmt.Fprintf(w, "  style %s stroke-width:3px\n", ids[l.Root])
+}
+
+// Writers of the lineage by format.
+var lineageWriters = map[string]func(*Lineage, io.Writer){
+	"dot":     (*Lineage).WriteDot,
+	"mermaid": (*Lineage).WriteMermaid,
+}
+
+// LineageFormats returns the sorted names of the lineage diagram formats.
+func LineageFormats() []string {
+	var names []string
+
+	for n := range lineageWriters {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func fieldIDPath(id dms.FieldID) string {
+	return fmt.Sprintf("/models/%s/%s/%s/%s", id.Model, id.Version, id.Table, id.Field)
+}
+
+// WriteLineageMarkdown writes the mappings of the lineage as a Markdown table
+// followed by the Mermaid diagram.
+func WriteLineageMarkdown(w io.Writer, l *Lineage) {
+	fmt.Fprintf(w, "# Lineage of %s\n\n", l.Root)
+
+	fmt.Fprintf(w, "%d fields in %d mappings\n", len(l.Nodes), len(l.Edges))
+
+	if len(l.Edges) == 0 {
+		return
+	}
+
+	fmt.Fprint(w, "\nFrom | To | Depth | Comment\n")
+	fmt.Fprint(w, "---- | -- | ----- | -------\n")
+
+	depths := make(map[dms.FieldID]int, len(l.Nodes))
+
+	for _, n := range l.Nodes {
+		depths[n.FieldID] = n.Depth
+	}
+
+	for _, e := range l.Edges {
+		fmt.Fprintf(w, "[%s](%s) | [%s](%s) | %d | %s\n", e.From, fieldIDPath(e.From), e.To, fieldIDPath(e.To), depths[e.To], e.Comment)
+	}
+
+	fmt.Fprint(w, "\n```mermaid\n")
+	l.WriteMermaid(w)
+	fmt.Fprint(w, "```\n")
+}
@@ -17,6 +17,7 @@ import (
 var (
 	registeredRepos Repos
 	reposDir        string
+	cloneDepth      int
 	secret          string
 	googleAnalytics string
 	serviceName     string
@@ -31,11 +32,19 @@ func main() {
 		interval time.Duration
 	)
 
+	// Run a subcommand instead of the service.
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			os.Exit(cmd(os.Args[2:]))
+		}
+	}
+
 	// Bind and parse flags
 	flag.StringVar(&loglevel, "log", "info", "Specify the log level.")
 	flag.StringVar(&host, "host", "127.0.0.1", "Host or IP to bind to.")
 	flag.IntVar(&port, "port", 8123, "Port to bind to.")
 	flag.StringVar(&reposDir, "path", "data-models", "Local directory of the cloned repos")
+	flag.IntVar(&cloneDepth, "depth", 0, "Number of commits to clone, 0 clones the full history.")
 	flag.DurationVar(&interval, "interval", time.Hour, "The interval for checking for updates.")
 	flag.StringVar(&secret, "secret", "", "Secret for webhook integration.")
 	flag.StringVar(&googleAnalytics, "ga", "", "Google Analytics tracking code.")
@@ -66,8 +75,7 @@ func main() {
 	}
 
 	if len(registeredRepos) == 0 {
-		repo, _ := ParseRepo(defaultRepoName)
-		registeredRepos = append(registeredRepos, repo)
+		registeredRepos.Set(defaultRepoName)
 	}
 
 	// Setup routes.
@@ -84,8 +92,17 @@ func main() {
 	router.GET("/models/:name/:version", httpModelVersion)
 	router.GET("/models/:name/:version/:table", httpTable)
 	router.GET("/models/:name/:version/:table/:field", httpField)
+	router.GET("/models/:name/:version/:table/:field/history", httpFieldHistory)
+	router.GET("/mappings/:name1/:version1/:name2/:version2", httpMappings)
+	router.GET("/lineage/:name/:version/:table/:field", httpLineage)
 	router.GET("/compare/:name1/:version1/:name2/:version2", httpCompareModels)
+	router.GET("/history/:name/:version", httpHistory)
 	router.GET("/schemata/:name/:version", httpModelSchema)
+	router.GET("/search", httpSearch)
+	router.GET("/query", httpQuery)
+	router.POST("/query", httpQuery)
+	router.GET("/graphql", httpGraphQL)
+	router.POST("/graphql", httpGraphQL)
 
 	// Endpoint for webhook integration.
 	router.POST("/_hook", httpUpdateRepos)
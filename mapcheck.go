@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Order of the integer types by width.
+var integerWidths = map[string]int{
+	smallIntegerType: 1,
+	integerType:      2,
+	bigIntegerType:   3,
+}
+
+// Generic types of each kind of value.
+var (
+	stringTypes  = map[string]bool{stringType: true, textType: true}
+	numericTypes = map[string]bool{
+		smallIntegerType: true,
+		integerType:      true,
+		bigIntegerType:   true,
+		decimalType:      true,
+		floatType:        true,
+		doubleType:       true,
+	}
+	temporalTypes = map[string]bool{dateType: true, timeType: true, datetimeType: true}
+)
+
+// convertType returns a warning if values of the source type may be lost or
+// rejected when converted to the target type, or an empty string if the
+// conversion is safe.
+func convertType(st, tt string) string {
+	switch {
+	case st == tt:
+		return ""
+
+	// Any value can be written as text.
+	case stringTypes[tt]:
+		return ""
+
+	case stringTypes[st]:
+		return fmt.Sprintf("text values must be parsed as %s", tt)
+
+	case integerWidths[st] > 0 && integerWidths[tt] > 0:
+		if integerWidths[st] > integerWidths[tt] {
+			return fmt.Sprintf("%s values may overflow %s", st, tt)
+		}
+
+		return ""
+
+	case integerWidths[st] > 0 && numericTypes[tt]:
+		return ""
+
+	case numericTypes[st] && integerWidths[tt] > 0:
+		return fmt.Sprintf("fractional %s values are truncated to %s", st, tt)
+
+	case numericTypes[st] && numericTypes[tt]:
+		if tt == floatType || tt == doubleType {
+			return fmt.Sprintf("%s values may lose precision as %s", st, tt)
+		}
+
+		return ""
+
+	case st == booleanType && integerWidths[tt] > 0:
+		return ""
+
+	case st == dateType && tt == datetimeType:
+		return ""
+
+	case st == datetimeType && temporalTypes[tt]:
+		return fmt.Sprintf("datetime values are truncated to %s", tt)
+	}
+
+	return fmt.Sprintf("%s values cannot be converted to %s", st, tt)
+}
+
+// checkMapping compares the schema of the source field with the schema of
+// the target field and returns warnings about values that may be lost or
+// rejected when the source is loaded into the target. Sizes of zero are
+// unbounded.
+func checkMapping(sf, tf *dms.Field, sts, tts *tableSchema) []string {
+	var warnings []string
+
+	// Fields without a declared type cannot be compared.
+	if sf.Type != "" && tf.Type != "" {
+		st, tt := genericType(sf), genericType(tf)
+
+		// Unknown types are only compared by name.
+		if st == "" || tt == "" {
+			if !strings.EqualFold(sf.Type, tf.Type) {
+				warnings = append(warnings, fmt.Sprintf("type %s is mapped to %s", fieldType(sf), fieldType(tf)))
+			}
+		} else if msg := convertType(st, tt); msg != "" {
+			warnings = append(warnings, fmt.Sprintf("type %s is mapped to %s: %s", fieldType(sf), fieldType(tf), msg))
+		}
+
+		if stringTypes[st] && stringTypes[tt] && tf.Length > 0 && (sf.Length == 0 || sf.Length > tf.Length) {
+			if sf.Length == 0 {
+				warnings = append(warnings, fmt.Sprintf("unbounded values may be truncated to length %d", tf.Length))
+			} else {
+				warnings = append(warnings, fmt.Sprintf("length %d may be truncated to %d", sf.Length, tf.Length))
+			}
+		}
+
+		if st == decimalType && tt == decimalType {
+			if tf.Precision > 0 && (sf.Precision == 0 || sf.Precision > tf.Precision) {
+				if sf.Precision == 0 {
+					warnings = append(warnings, fmt.Sprintf("unbounded values may overflow precision %d", tf.Precision))
+				} else {
+					warnings = append(warnings, fmt.Sprintf("precision %d may overflow %d", sf.Precision, tf.Precision))
+				}
+			}
+
+			if sf.Scale > tf.Scale {
+				warnings = append(warnings, fmt.Sprintf("scale %d is rounded to %d", sf.Scale, tf.Scale))
+			}
+		}
+	}
+
+	if isNotNull(tf, tts) && !isNotNull(sf, sts) {
+		warnings = append(warnings, "required field is mapped from an optional field")
+	}
+
+	return warnings
+}
@@ -0,0 +1,470 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Phases of a migration script. Statements are grouped by phase so the
+// script can be run top to bottom, e.g. foreign keys are dropped before the
+// columns they constrain.
+const (
+	dropForeignKeysPhase = iota
+	dropIndexesPhase
+	dropConstraintsPhase
+	renameTablesPhase
+	renameColumnsPhase
+	createTablesPhase
+	addColumnsPhase
+	alterColumnsPhase
+	dropColumnsPhase
+	dropTablesPhase
+	addConstraintsPhase
+	addForeignKeysPhase
+	createIndexesPhase
+	numPhases
+)
+
+var migrationPhaseTitles = [numPhases]string{
+	"Drop foreign keys",
+	"Drop indexes",
+	"Drop constraints",
+	"Rename tables",
+	"Rename columns",
+	"Create tables",
+	"Add columns",
+	"Alter columns",
+	"Drop columns",
+	"Drop tables",
+	"Add constraints",
+	"Add foreign keys",
+	"Create indexes",
+}
+
+// migrationStmt is a statement of a migration script. Statements which
+// are not supported by the dialect only have a comment. Risky statements
+// do not drop data but may fail depending on the data.
+type migrationStmt struct {
+	SQL         string
+	Comment     string
+	Destructive bool
+	Risky       bool
+}
+
+// migration collects the statements of a migration script.
+type migration struct {
+	d      Dialect
+	phases [numPhases][]*migrationStmt
+}
+
+func (m *migration) add(phase int, sql string) {
+	m.phases[phase] = append(m.phases[phase], &migrationStmt{
+		SQL: sql,
+	})
+}
+
+func (m *migration) addDestructive(phase int, sql, comment string) {
+	m.phases[phase] = append(m.phases[phase], &migrationStmt{
+		SQL:         sql,
+		Comment:     comment,
+		Destructive: true,
+	})
+}
+
+func (m *migration) addRisky(phase int, sql, comment string) {
+	m.phases[phase] = append(m.phases[phase], &migrationStmt{
+		SQL:     sql,
+		Comment: comment,
+		Risky:   true,
+	})
+}
+
+// manual adds a note for a change that cannot be scripted in the dialect.
+func (m *migration) manual(phase int, comment string) {
+	m.phases[phase] = append(m.phases[phase], &migrationStmt{
+		Comment: comment,
+	})
+}
+
+// flagged returns the number of destructive and risky statements.
+func (m *migration) flagged() (int, int) {
+	var destructive, risky int
+
+	for _, stmts := range m.phases {
+		for _, s := range stmts {
+			if s.Destructive {
+				destructive++
+			} else if s.Risky {
+				risky++
+			}
+		}
+	}
+
+	return destructive, risky
+}
+
+func (m *migration) Write(w io.Writer) {
+	destructive, risky := m.flagged()
+
+	if destructive > 0 {
+		fmt.Fprintln(w, "--")
+		fmt.Fprintf(w, "-- %d destructive statement(s) are marked with DESTRUCTIVE.\n", destructive)
+		fmt.Fprintln(w, "-- Review them and back up the affected data before running the script.")
+	}
+
+	if risky > 0 {
+		fmt.Fprintln(w, "--")
+		fmt.Fprintf(w, "-- %d risky statement(s) are marked with RISKY.\n", risky)
+		fmt.Fprintln(w, "-- They fail if existing rows do not satisfy them.")
+	}
+
+	for i, stmts := range m.phases {
+		if len(stmts) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n-- %s\n\n", migrationPhaseTitles[i])
+
+		for j, s := range stmts {
+			// Separate multi-line statements such as CREATE TABLE.
+			if j > 0 && (strings.Contains(s.SQL, "\n") || strings.Contains(stmts[j-1].SQL, "\n")) {
+				fmt.Fprintln(w)
+			}
+
+			switch {
+			case s.Destructive:
+				fmt.Fprintf(w, "-- DESTRUCTIVE: %s\n", s.Comment)
+			case s.Risky:
+				fmt.Fprintf(w, "-- RISKY: %s\n", s.Comment)
+			case s.Comment != "":
+				fmt.Fprintf(w, "-- %s\n", s.Comment)
+			}
+
+			if s.SQL != "" {
+				fmt.Fprintln(w, s.SQL)
+			}
+
+		}
+	}
+}
+
+// isNotNull returns true if the column is created as NOT NULL.
+func isNotNull(f *dms.Field, ts *tableSchema) bool {
+	return f.Required || ts.IsNotNull(f.Name)
+}
+
+// dropConstraint adds the statement dropping a constraint. Unnamed constraints
+// cannot be dropped by name so a note is added instead.
+func (m *migration) dropConstraint(phase int, table, name, kind string, fields []string) {
+	if m.d.InlineForeignKeys() {
+		m.manual(phase, fmt.Sprintf("%s cannot drop the %s constraint on %s (%s); rebuild the table", m.d.Name(), kind, table, strings.Join(fields, ", ")))
+		return
+	}
+
+	if name == "" {
+		m.manual(phase, fmt.Sprintf("unnamed %s constraint on %s (%s) must be dropped manually", kind, table, strings.Join(fields, ", ")))
+		return
+	}
+
+	m.add(phase, m.d.DropConstraint(table, shortenIdent(m.d, name), kind))
+}
+
+// addConstraint adds the statement adding a constraint to an existing table.
+func (m *migration) addConstraint(phase int, table, def string) {
+	if m.d.InlineForeignKeys() {
+		m.manual(phase, fmt.Sprintf("%s cannot add the constraint %s to %s; rebuild the table", m.d.Name(), def, table))
+		return
+	}
+
+	m.add(phase, fmt.Sprintf("ALTER TABLE %s ADD %s;", m.d.Quote(table), def))
+}
+
+// diffColumns adds the statements migrating the columns of a table that
+// exists in both models. The table is renamed before the columns are
+// migrated so statements refer to its new name.
+func (m *migration) diffColumns(at, bt *dms.Table, ats, bts *tableSchema, diff *Diff) {
+	d := m.d
+
+	for _, n := range diff.Added {
+		f := bt.Fields.Get(n)
+		stmt := d.AddColumn(bt.Name, columnDef(d, f, bts))
+
+		if isNotNull(f, bts) && f.Default == "" {
+			m.addRisky(addColumnsPhase, stmt, fmt.Sprintf("adds NOT NULL column %s.%s without a default, fails if the table has rows", bt.Name, n))
+		} else {
+			m.add(addColumnsPhase, stmt)
+		}
+	}
+
+	for _, n := range diff.Removed {
+		m.addDestructive(dropColumnsPhase,
+			fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", d.Quote(bt.Name), d.Quote(n)),
+			fmt.Sprintf("drops column %s.%s and its data", bt.Name, n))
+	}
+
+	for _, n := range diff.RenamedKeys() {
+		m.add(renameColumnsPhase, d.RenameColumn(bt.Name, n, diff.Renamed[n]))
+		m.alterColumn(bt, at.Fields.Get(n), bt.Fields.Get(diff.Renamed[n]), ats, bts)
+	}
+
+	for _, n := range diff.Matches {
+		m.alterColumn(bt, at.Fields.Get(n), bt.Fields.Get(n), ats, bts)
+	}
+}
+
+// merge merges a statement with the same SQL into the statement, keeping
+// the most severe flag and its comment.
+func (s *migrationStmt) merge(o *migrationStmt) {
+	switch {
+	case s.Destructive:
+	case o.Destructive, o.Risky && !s.Risky:
+		s.Destructive, s.Risky, s.Comment = o.Destructive, o.Risky, o.Comment
+	}
+}
+
+// alterColumn adds the statements changing the type, nullability and
+// default of a column. Dialects redefining the whole column return the
+// same statement for each change, which is added once.
+func (m *migration) alterColumn(bt *dms.Table, af, bf *dms.Field, ats, bts *tableSchema) {
+	d := m.d
+	n := bf.Name
+
+	notNull := isNotNull(bf, bts)
+
+	af = keyField(d, af, ats)
+	bf = keyField(d, bf, bts)
+
+	var stmts []*migrationStmt
+
+	added := make(map[string]*migrationStmt)
+
+	alter := func(sqls []string, s migrationStmt) {
+		for _, sql := range sqls {
+			s.SQL = sql
+
+			if prev, ok := added[sql]; ok {
+				prev.merge(&s)
+				continue
+			}
+
+			ns := s
+			added[sql] = &ns
+			stmts = append(stmts, &ns)
+		}
+	}
+
+	if from, to := d.Type(af), d.Type(bf); from != to {
+		sqls := d.AlterColumnType(bt.Name, bf, notNull)
+
+		if sqls == nil {
+			m.manual(alterColumnsPhase, fmt.Sprintf("%s cannot change the type of %s.%s from %s to %s; rebuild the table", d.Name(), bt.Name, n, from, to))
+		}
+
+		if widensField(af, bf) {
+			alter(sqls, migrationStmt{})
+		} else {
+			alter(sqls, migrationStmt{
+				Comment:     fmt.Sprintf("changes the type of %s.%s from %s to %s, existing values may not convert", bt.Name, n, from, to),
+				Destructive: true,
+			})
+		}
+	}
+
+	if isNotNull(af, ats) != notNull {
+		sqls := d.AlterColumnNull(bt.Name, bf, notNull)
+
+		if sqls == nil {
+			m.manual(alterColumnsPhase, fmt.Sprintf("%s cannot change the nullability of %s.%s; rebuild the table", d.Name(), bt.Name, n))
+		}
+
+		if notNull {
+			alter(sqls, migrationStmt{
+				Comment: fmt.Sprintf("makes %s.%s NOT NULL, fails if it has null values", bt.Name, n),
+				Risky:   true,
+			})
+		} else {
+			alter(sqls, migrationStmt{})
+		}
+	}
+
+	if af.Default != bf.Default {
+		sqls := d.AlterColumnDefault(bt.Name, bf, notNull)
+
+		if sqls == nil {
+			m.manual(alterColumnsPhase, fmt.Sprintf("%s cannot change the default of %s.%s; change it manually", d.Name(), bt.Name, n))
+		}
+
+		alter(sqls, migrationStmt{})
+	}
+
+	m.phases[alterColumnsPhase] = append(m.phases[alterColumnsPhase], stmts...)
+}
+
+// dropSchemaConstraint adds the statement dropping a constraint. Not null
+// constraints are migrated with the columns.
+func (m *migration) dropSchemaConstraint(c *constraint) {
+	switch c.Kind {
+	case "FOREIGN KEY":
+		m.dropConstraint(dropForeignKeysPhase, c.Table, c.Name, c.Kind, c.Fields)
+	case "PRIMARY KEY", "UNIQUE":
+		m.dropConstraint(dropConstraintsPhase, c.Table, c.Name, c.Kind, c.Fields)
+	}
+}
+
+// addSchemaConstraint adds the statement adding a constraint.
+func (m *migration) addSchemaConstraint(c *constraint) {
+	switch c.Kind {
+	case "FOREIGN KEY":
+		m.addConstraint(addForeignKeysPhase, c.Table, foreignKeyDef(m.d, &foreignKey{
+			Name:         c.Name,
+			Table:        c.Table,
+			Fields:       c.Fields,
+			TargetTable:  c.TargetTable,
+			TargetFields: c.TargetFields,
+		}))
+	case "PRIMARY KEY", "UNIQUE":
+		m.addConstraint(addConstraintsPhase, c.Table, constraintDef(m.d, c.Name, c.Kind, c.Fields))
+	}
+}
+
+// diffSchema adds the statements migrating the constraints and indexes of
+// the tables present in both models. Changed constraints and indexes are
+// dropped and added again. The constraints and indexes of created and
+// dropped tables are handled with the tables. Constraints and indexes are
+// dropped before tables are renamed and added after.
+func (m *migration) diffSchema(diff *ModelDiff) {
+	before := make(map[string]bool, len(diff.Fields))
+	after := make(map[string]bool, len(diff.Fields))
+
+	for _, td := range diff.Fields {
+		after[strings.ToLower(td.Table)] = true
+
+		if td.RenamedFrom != "" {
+			before[strings.ToLower(td.RenamedFrom)] = true
+		} else {
+			before[strings.ToLower(td.Table)] = true
+		}
+	}
+
+	acons := modelConstraints(diff.From.Schema)
+	bcons := modelConstraints(diff.To.Schema)
+
+	for _, k := range append(diff.Constraints.Removed, diff.Constraints.ChangedKeys()...) {
+		if c := acons[k]; before[strings.ToLower(c.Table)] {
+			m.dropSchemaConstraint(c)
+		}
+	}
+
+	for _, k := range append(diff.Constraints.Added, diff.Constraints.ChangedKeys()...) {
+		if c := bcons[k]; after[strings.ToLower(c.Table)] {
+			m.addSchemaConstraint(c)
+		}
+	}
+
+	aidxs := modelIndexes(diff.From.Schema)
+	bidxs := modelIndexes(diff.To.Schema)
+
+	for _, k := range append(diff.Indexes.Removed, diff.Indexes.ChangedKeys()...) {
+		if idx := aidxs[k]; before[strings.ToLower(idx.Table)] {
+			m.add(dropIndexesPhase, m.d.DropIndex(idx.Table, shortenIdent(m.d, indexName(idx))))
+		}
+	}
+
+	for _, k := range append(diff.Indexes.Added, diff.Indexes.ChangedKeys()...) {
+		if idx := bidxs[k]; after[strings.ToLower(idx.Table)] {
+			m.add(createIndexesPhase, createIndex(m.d, idx))
+		}
+	}
+}
+
+// WriteMigrationSQL writes a script in the SQL dialect which migrates a
+// database created from the model the diff is from to the model the diff
+// is to. Statements which drop data are marked as destructive.
+func WriteMigrationSQL(w io.Writer, diff *ModelDiff, d Dialect) {
+	mg := &migration{d: d}
+
+	a, b := diff.From, diff.To
+
+	aschema := indexSchema(a.Schema)
+	bschema := indexSchema(b.Schema)
+
+	tableDiff := diff.Tables
+
+	added := make(map[string]bool, len(tableDiff.Added))
+	removed := make(map[string]bool, len(tableDiff.Removed))
+
+	for _, n := range tableDiff.Added {
+		added[n] = true
+	}
+
+	for _, n := range tableDiff.Removed {
+		removed[n] = true
+	}
+
+	// New tables are created in dependency order.
+	for _, t := range sortTablesByDependency(b, bschema) {
+		if !added[t.Name] {
+			continue
+		}
+
+		ts := schemaForTable(bschema, t.Name)
+
+		buf := bytes.NewBuffer(nil)
+		writeCreateTable(buf, d, t, ts)
+		mg.add(createTablesPhase, strings.TrimSpace(buf.String()))
+
+		if !d.InlineForeignKeys() {
+			for _, def := range foreignKeyDefs(d, ts) {
+				mg.add(addForeignKeysPhase, fmt.Sprintf("ALTER TABLE %s ADD %s;", d.Quote(t.Name), def))
+			}
+		}
+
+		for _, idx := range ts.Indexes {
+			mg.add(createIndexesPhase, createIndex(d, idx))
+		}
+	}
+
+	// Removed tables are dropped in reverse dependency order.
+	atables := sortTablesByDependency(a, aschema)
+
+	for i := len(atables) - 1; i >= 0; i-- {
+		t := atables[i]
+
+		if !removed[t.Name] {
+			continue
+		}
+
+		mg.addDestructive(dropTablesPhase,
+			fmt.Sprintf("DROP TABLE %s;", d.Quote(t.Name)),
+			fmt.Sprintf("drops table %s and its data", t.Name))
+	}
+
+	for _, td := range diff.Fields {
+		from := td.Table
+
+		if td.RenamedFrom != "" {
+			from = td.RenamedFrom
+			mg.add(renameTablesPhase, d.RenameTable(from, td.Table))
+		}
+
+		at := a.Tables.Get(from)
+		bt := b.Tables.Get(td.Table)
+
+		ats := schemaForTable(aschema, from)
+		bts := schemaForTable(bschema, td.Table)
+
+		mg.diffColumns(at, bt, ats, bts, td.Fields)
+	}
+
+	mg.diffSchema(diff)
+
+	fmt.Fprintf(w, "-- Migration from %s (%s) to %s (%s)\n", a, a.URLPath(), b, b.URLPath())
+	fmt.Fprintf(w, "-- Dialect: %s\n", d.Name())
+	fmt.Fprintf(w, "-- Generated by %s %s\n", serviceName, progVersion)
+
+	mg.Write(w)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// migrationPhase returns the lines of a phase of a migration script.
+func migrationPhase(script, title string) []string {
+	var lines []string
+
+	in := false
+
+	for _, l := range strings.Split(script, "\n") {
+		switch {
+		case l == "-- "+title:
+			in = true
+		case !in:
+		case l == "":
+			if len(lines) > 0 {
+				return lines
+			}
+		default:
+			lines = append(lines, l)
+		}
+	}
+
+	return lines
+}
+
+func TestMigrationAlterColumns(t *testing.T) {
+	fields := "person_id,Person id,yes\n" +
+		"name,Name,no\n"
+
+	schema := "person_id,integer,,,,\n" +
+		"name,varchar,50,,,unknown\n"
+
+	tests := []struct {
+		name    string
+		dialect string
+		fields  string
+		schema  string
+		stmts   []string
+	}{
+		{
+			"length widened",
+			"postgresql",
+			fields,
+			"person_id,integer,,,,\n" +
+				"name,varchar,100,,,unknown\n",
+			[]string{
+				`ALTER TABLE "person" ALTER COLUMN "name" TYPE varchar(100);`,
+			},
+		},
+		{
+			"length widened",
+			"mysql",
+			fields,
+			"person_id,integer,,,,\n" +
+				"name,varchar,100,,,unknown\n",
+			[]string{
+				"ALTER TABLE `person` MODIFY COLUMN `name` varchar(100) DEFAULT 'unknown' NULL;",
+			},
+		},
+		{
+			"type widened",
+			"postgresql",
+			fields,
+			"person_id,bigint,,,,\n" +
+				"name,varchar,50,,,unknown\n",
+			[]string{
+				`ALTER TABLE "person" ALTER COLUMN "person_id" TYPE bigint;`,
+			},
+		},
+		{
+			"type widened",
+			"mysql",
+			fields,
+			"person_id,bigint,,,,\n" +
+				"name,varchar,50,,,unknown\n",
+			[]string{
+				"ALTER TABLE `person` MODIFY COLUMN `person_id` bigint NOT NULL;",
+			},
+		},
+		{
+			"type changed",
+			"postgresql",
+			fields,
+			"person_id,varchar,20,,,\n" +
+				"name,varchar,50,,,unknown\n",
+			[]string{
+				"-- DESTRUCTIVE: changes the type of person.person_id from integer to varchar(20), existing values may not convert",
+				`ALTER TABLE "person" ALTER COLUMN "person_id" TYPE varchar(20);`,
+			},
+		},
+		{
+			"length narrowed and required",
+			"postgresql",
+			"person_id,Person id,yes\n" +
+				"name,Name,yes\n",
+			"person_id,integer,,,,\n" +
+				"name,varchar,20,,,unknown\n",
+			[]string{
+				"-- DESTRUCTIVE: changes the type of person.name from varchar(50) to varchar(20), existing values may not convert",
+				`ALTER TABLE "person" ALTER COLUMN "name" TYPE varchar(20);`,
+				"-- RISKY: makes person.name NOT NULL, fails if it has null values",
+				`ALTER TABLE "person" ALTER COLUMN "name" SET NOT NULL;`,
+			},
+		},
+		{
+			"length narrowed and required",
+			"mysql",
+			"person_id,Person id,yes\n" +
+				"name,Name,yes\n",
+			"person_id,integer,,,,\n" +
+				"name,varchar,20,,,unknown\n",
+			[]string{
+				"-- DESTRUCTIVE: changes the type of person.name from varchar(50) to varchar(20), existing values may not convert",
+				"ALTER TABLE `person` MODIFY COLUMN `name` varchar(20) DEFAULT 'unknown' NOT NULL;",
+			},
+		},
+		{
+			"default changed",
+			"postgresql",
+			fields,
+			"person_id,integer,,,,\n" +
+				"name,varchar,50,,,n/a\n",
+			[]string{
+				`ALTER TABLE "person" ALTER COLUMN "name" SET DEFAULT 'n/a';`,
+			},
+		},
+		{
+			"default changed",
+			"mysql",
+			fields,
+			"person_id,integer,,,,\n" +
+				"name,varchar,50,,,n/a\n",
+			[]string{
+				"ALTER TABLE `person` MODIFY COLUMN `name` varchar(50) DEFAULT 'n/a' NULL;",
+			},
+		},
+		{
+			"default dropped and widened",
+			"postgresql",
+			fields,
+			"person_id,integer,,,,\n" +
+				"name,text,,,,\n",
+			[]string{
+				`ALTER TABLE "person" ALTER COLUMN "name" TYPE text;`,
+				`ALTER TABLE "person" ALTER COLUMN "name" DROP DEFAULT;`,
+			},
+		},
+		{
+			"default dropped and widened",
+			"mysql",
+			fields,
+			"person_id,integer,,,,\n" +
+				"name,text,,,,\n",
+			[]string{
+				"ALTER TABLE `person` MODIFY COLUMN `name` longtext NULL;",
+			},
+		},
+	}
+
+	a := loadTestModel(t, "1.0.0", fields, schema)
+
+	for _, test := range tests {
+		b := loadTestModel(t, "2.0.0", test.fields, test.schema)
+
+		buf := bytes.NewBuffer(nil)
+		WriteMigrationSQL(buf, DiffModels(a, b, RenamesOff), GetDialect(test.dialect))
+
+		stmts := migrationPhase(buf.String(), "Alter columns")
+
+		if strings.Join(stmts, "\n") != strings.Join(test.stmts, "\n") {
+			t.Errorf("%s %s: expected\n%s\ngot\n%s", test.dialect, test.name, strings.Join(test.stmts, "\n"), strings.Join(stmts, "\n"))
+		}
+	}
+}
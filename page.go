@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// schemaRow is a constraint or index listed on a table or field page.
+type schemaRow struct {
+	Kind       string
+	Name       string
+	Definition string
+}
+
+// Constraints are listed by kind, then name.
+var schemaRowOrder = map[string]int{
+	"PRIMARY KEY":  0,
+	"UNIQUE":       1,
+	"FOREIGN KEY":  2,
+	"NOT NULL":     3,
+	"UNIQUE INDEX": 4,
+	"INDEX":        5,
+}
+
+type schemaRowsByKind []*schemaRow
+
+func (s schemaRowsByKind) Len() int { return len(s) }
+func (s schemaRowsByKind) Less(i, j int) bool {
+	if s[i].Kind != s[j].Kind {
+		return schemaRowOrder[s[i].Kind] < schemaRowOrder[s[j].Kind]
+	}
+
+	return s[i].Definition < s[j].Definition
+}
+func (s schemaRowsByKind) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func containsFold(l []string, s string) bool {
+	for _, x := range l {
+		if strings.EqualFold(x, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// schemaRows returns the constraints and indexes of the model that the keep
+// function accepts.
+func schemaRows(s *dms.Schema, keepConstraint func(*constraint) bool, keepIndex func(*dms.Index) bool) []*schemaRow {
+	var rows []*schemaRow
+
+	for _, c := range modelConstraints(s) {
+		if keepConstraint(c) {
+			rows = append(rows, &schemaRow{
+				Kind:       c.Kind,
+				Name:       c.Name,
+				Definition: c.String(),
+			})
+		}
+	}
+
+	for _, idx := range modelIndexes(s) {
+		if !keepIndex(idx) {
+			continue
+		}
+
+		kind := "INDEX"
+
+		if idx.Unique {
+			kind = "UNIQUE INDEX"
+		}
+
+		rows = append(rows, &schemaRow{
+			Kind:       kind,
+			Name:       idx.Name,
+			Definition: indexKey(idx),
+		})
+	}
+
+	sort.Sort(schemaRowsByKind(rows))
+
+	return rows
+}
+
+// modelPage is the data of the full model template.
+type modelPage struct {
+	*dms.Model
+
+	// Mermaid diagram of the tables.
+	Diagram string
+}
+
+func newModelPage(m *dms.Model) *modelPage {
+	var b bytes.Buffer
+
+	if e, err := NewERD(m, &ERDOptions{}); err == nil && len(e.Tables) > 0 {
+		e.WriteMermaid(&b)
+	}
+
+	return &modelPage{
+		Model:   m,
+		Diagram: b.String(),
+	}
+}
+
+// tablePage is the data of the table template.
+type tablePage struct {
+	Table  *dms.Table
+	Schema []*schemaRow
+}
+
+func newTablePage(t *dms.Table) *tablePage {
+	return &tablePage{
+		Table: t,
+		Schema: schemaRows(t.Model.Schema, func(c *constraint) bool {
+			return strings.EqualFold(c.Table, t.Name)
+		}, func(idx *dms.Index) bool {
+			return strings.EqualFold(idx.Table, t.Name)
+		}),
+	}
+}
+
+// fieldPage is the data of the field template.
+type fieldPage struct {
+	Field   *dms.Field
+	NotNull bool
+	Schema  []*schemaRow
+}
+
+// newFieldPage collects the constraints and indexes involving the field,
+// including foreign keys of other tables referencing it.
+func newFieldPage(f *dms.Field) *fieldPage {
+	t := f.Table
+	ts := schemaForTable(indexSchema(t.Model.Schema), t.Name)
+
+	return &fieldPage{
+		Field:   f,
+		NotNull: isNotNull(f, ts),
+		Schema: schemaRows(t.Model.Schema, func(c *constraint) bool {
+			if strings.EqualFold(c.Table, t.Name) && containsFold(c.Fields, f.Name) {
+				return true
+			}
+
+			return strings.EqualFold(c.TargetTable, t.Name) && containsFold(c.TargetFields, f.Name)
+		}, func(idx *dms.Index) bool {
+			return strings.EqualFold(idx.Table, t.Name) && containsFold(idx.Fields, f.Name)
+		}),
+	}
+}
@@ -15,6 +15,13 @@ import (
 
 const modelFileName = "models.csv"
 
+// record is a row of a definitions file and its location.
+type record struct {
+	attrs dms.Attrs
+	path  string
+	line  int
+}
+
 // TableFieldIndex is an index by table, then field name to attributes.
 type TableFieldIndex map[string]map[string]dms.Attrs
 
@@ -61,7 +68,16 @@ func rebuildCache() {
 	// Find models across repos.
 	for _, r := range registeredRepos {
 		go func(r *Repo) {
+			if r.offRef {
+				wg.Done()
+				return
+			}
+
 			for _, m := range findModels(r.path) {
+				m.Ref = r.Ref
+				m.Commit = r.CommitSHA1
+				m.Qualified = !r.Default
+
 				wg.Add(1)
 				models <- m
 			}
@@ -97,15 +113,86 @@ func rebuildCache() {
 
 	close(models)
 
-	// Parse mapping serially since it crosses the model boundary.
+	// Parse mappings and renames serially since they cross the model boundary.
 	for _, r := range registeredRepos {
-		parseMappings(cache, r.path)
+		if r.offRef {
+			continue
+		}
+
+		parseMappings(cache, r.path, r.Ref)
+		parseRenames(cache, r.path, r.Ref)
 	}
 
 	dataModelCache = cache
+	searchIndex = buildSearchIndex(cache)
 }
 
-func parseMappings(models *dms.Models, path string) {
+// loadModels finds and parses the models in a directory without touching
+// the model cache. This is used by the subcommands which operate on a local
+// checkout of a repository.
+func loadModels(root string) *dms.Models {
+	models := new(dms.Models)
+
+	for _, m := range findModels(root) {
+		parseFiles(m)
+		models.Add(m)
+	}
+
+	parseMappings(models, root, "")
+	parseRenames(models, root, "")
+
+	return models
+}
+
+// getModel returns the model of the ref if the ref has one, otherwise the
+// model of the default ref. Definitions files of a ref may refer to models
+// of other repositories which are not checked out at the same ref.
+func getModel(models *dms.Models, n, v, ref string) *dms.Model {
+	if ref != "" {
+		if m := models.Get(n, v+"@"+ref); m != nil {
+			return m
+		}
+	}
+
+	return models.Get(n, v)
+}
+
+// modelOfFile returns the model in the directory of which the file is,
+// nearest first.
+func modelOfFile(models *dms.Models, path string) *dms.Model {
+	var owner *dms.Model
+
+	dir := filepath.Dir(path)
+
+	for _, m := range models.List() {
+		if m.Path == "" {
+			continue
+		}
+
+		if dir != m.Path && !strings.HasPrefix(dir, m.Path+string(filepath.Separator)) {
+			continue
+		}
+
+		if owner == nil || len(m.Path) > len(owner.Path) {
+			owner = m
+		}
+	}
+
+	return owner
+}
+
+func parseMappings(models *dms.Models, path, ref string) {
+	// Indexed schemas of the models for the checks of the mapped fields.
+	schemas := make(map[*dms.Model]map[string]*tableSchema)
+
+	schema := func(m *dms.Model) map[string]*tableSchema {
+		if _, ok := schemas[m]; !ok {
+			schemas[m] = indexSchema(m.Schema)
+		}
+
+		return schemas[m]
+	}
+
 	// Load all the definitions files.
 	filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
 		// Ignore errors.
@@ -157,53 +244,71 @@ func parseMappings(models *dms.Models, path string) {
 			// 1 header + 1-indexed
 			lineno += 2
 
+			sm = getModel(models, r["source_model"], r["source_version"], ref)
+			tm = getModel(models, r["target_model"], r["target_version"], ref)
+
+			// Issues are reported on the source model if it exists.
+			im := sm
+
+			if im == nil {
+				im = tm
+			}
+
 			// Ignore incomplete mappings.
 			if r["source_field"] == "" || r["target_field"] == "" {
-				logrus.Infof("mapping (%s:%d): incomplete mapping", path, lineno)
+				addIssue(im, severityInfo, ruleIncompleteMap, path, lineno, "incomplete mapping")
 				continue
 			}
 
-			if sm = models.Get(r["source_model"], r["source_version"]); sm == nil {
-				logrus.Warnf("mapping (%s:%d): no model %s/%s", path, lineno, r["source_model"], r["source_version"])
+			if sm == nil {
+				addIssue(im, severityWarning, ruleDanglingMap, path, lineno, "no model %s/%s", r["source_model"], r["source_version"])
 				continue
 			}
 
-			if tm = models.Get(r["target_model"], r["target_version"]); tm == nil {
-				logrus.Warnf("mapping (%s:%d): no model %s/%s", path, lineno, r["target_model"], r["target_version"])
+			if tm == nil {
+				addIssue(im, severityWarning, ruleDanglingMap, path, lineno, "no model %s/%s", r["target_model"], r["target_version"])
 				continue
 			}
 
 			if st = sm.Tables.Get(r["source_table"]); st == nil {
-				logrus.Warnf("mapping (%s:%d): no table %s/%s", path, lineno, sm, r["source_table"])
+				addIssue(im, severityWarning, ruleDanglingMap, path, lineno, "no table %s/%s", sm, r["source_table"])
 				continue
 			}
 
 			if tt = tm.Tables.Get(r["target_table"]); tt == nil {
-				logrus.Warnf("mapping (%s:%d): no table %s/%s", path, lineno, tm, r["target_table"])
+				addIssue(im, severityWarning, ruleDanglingMap, path, lineno, "no table %s/%s", tm, r["target_table"])
 				continue
 			}
 
 			if sf = st.Fields.Get(r["source_field"]); sf == nil {
-				logrus.Warnf("mapping (%s:%d): no field %s/%s", path, lineno, st, r["source_field"])
+				addIssue(im, severityWarning, ruleDanglingMap, path, lineno, "no field %s/%s", st, r["source_field"])
 				continue
 			}
 
 			if tf = tt.Fields.Get(r["target_field"]); tf == nil {
-				logrus.Warnf("mapping (%s:%d): no field %s/%s", path, lineno, tt, r["target_field"])
+				addIssue(im, severityWarning, ruleDanglingMap, path, lineno, "no field %s/%s", tt, r["target_field"])
 				continue
 			}
 
+			warnings := checkMapping(sf, tf, schemaForTable(schema(sm), st.Name), schemaForTable(schema(tm), tt.Name))
+
+			for _, w := range warnings {
+				addIssue(im, severityWarning, ruleLossyMap, path, lineno, "%s.%s to %s.%s of %s: %s", st.Name, sf.Name, tt.Name, tf.Name, tm, w)
+			}
+
 			// Bi-directional mapping.
 			mp = &dms.Mapping{
-				Field:   sf,
-				Comment: r["comment"],
+				Field:    sf,
+				Comment:  r["comment"],
+				Warnings: warnings,
 			}
 
 			tf.Mappings = append(tf.Mappings, mp)
 
 			mp = &dms.Mapping{
-				Field:   tf,
-				Comment: r["comment"],
+				Field:    tf,
+				Comment:  r["comment"],
+				Warnings: warnings,
 			}
 
 			sf.Mappings = append(sf.Mappings, mp)
@@ -213,13 +318,122 @@ func parseMappings(models *dms.Models, path string) {
 	})
 }
 
+// parseRenames links the fields and tables of a model version to the fields
+// and tables of the previous version they were renamed from.
+func parseRenames(models *dms.Models, path, ref string) {
+	filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		// Ignore errors.
+		if err != nil {
+			return nil
+		}
+
+		// Nothing to do with directories.
+		if info.IsDir() {
+			return nil
+		}
+
+		// Skip non-CSV files.
+		if filepath.Ext(path) != ".csv" {
+			return nil
+		}
+
+		f, err := os.Open(path)
+
+		if err != nil {
+			return nil
+		}
+
+		defer f.Close()
+
+		r := NewMapCSVReader(f)
+
+		if detectFileType(r.Fields()) != RenamesFile {
+			return nil
+		}
+
+		logrus.Debugf("parse (%s): found renames file", path)
+
+		records, err := r.ReadAll()
+
+		if err != nil || len(records) == 0 {
+			return nil
+		}
+
+		var (
+			m, pm  *dms.Model
+			t, pt  *dms.Table
+			fd, pf *dms.Field
+		)
+
+		owner := modelOfFile(models, path)
+
+		for lineno, r := range records {
+			// 1 header + 1-indexed
+			lineno += 2
+
+			if m = getModel(models, r["model"], r["version"], ref); m == nil {
+				// Reported on the model the file belongs to, otherwise on the
+				// previous version.
+				im := owner
+
+				if im == nil {
+					im = getModel(models, r["model"], r["prev_version"], ref)
+				}
+
+				addIssue(im, severityWarning, ruleDanglingRename, path, lineno, "no model %s/%s", r["model"], r["version"])
+				continue
+			}
+
+			if pm = getModel(models, r["model"], r["prev_version"], ref); pm == nil {
+				addIssue(m, severityWarning, ruleDanglingRename, path, lineno, "no model %s/%s", r["model"], r["prev_version"])
+				continue
+			}
+
+			if t = m.Tables.Get(r["table"]); t == nil {
+				addIssue(m, severityWarning, ruleDanglingRename, path, lineno, "no table %s/%s", m, r["table"])
+				continue
+			}
+
+			if pt = pm.Tables.Get(r["prev_table"]); pt == nil {
+				addIssue(m, severityWarning, ruleDanglingRename, path, lineno, "no table %s/%s", pm, r["prev_table"])
+				continue
+			}
+
+			if t.Name != pt.Name {
+				t.RenamedFrom = pt
+				pt.RenamedTo = t
+			}
+
+			// Table renames do not require a field.
+			if r["field"] == "" && r["prev_field"] == "" {
+				continue
+			}
+
+			if fd = t.Fields.Get(r["field"]); fd == nil {
+				addIssue(m, severityWarning, ruleDanglingRename, path, lineno, "no field %s/%s", t, r["field"])
+				continue
+			}
+
+			if pf = pt.Fields.Get(r["prev_field"]); pf == nil {
+				addIssue(m, severityWarning, ruleDanglingRename, path, lineno, "no field %s/%s", pt, r["prev_field"])
+				continue
+			}
+
+			fd.RenamedFrom = pf
+			pf.RenamedTo = fd
+		}
+
+		return nil
+	})
+}
+
 // parseFiles finds and parses all definitions files in the passed directory.
 func parseFiles(model *dms.Model) {
 	var (
 		ok        bool
 		table     string
 		tableList []dms.Attrs
-		refs      []*dms.Reference
+		refs      []*record
 	)
 
 	// Initialize
@@ -233,6 +447,10 @@ func parseFiles(model *dms.Model) {
 	tableFields := make(map[string][]dms.Attrs)
 	fieldSchemata := make(TableFieldIndex)
 
+	// Location of the first field of each table for reporting fields of
+	// undeclared tables.
+	tableFieldsLocs := make(map[string]*record)
+
 	// Load all the definitions files.
 	filepath.Walk(model.Path, func(path string, info os.FileInfo, err error) error {
 		// Ignore errors.
@@ -263,15 +481,20 @@ func parseFiles(model *dms.Model) {
 		fileType := detectFileType(r.Fields())
 
 		if fileType == UnknownType {
-			logrus.Warnf("parse (%s): could not detect file type", path)
+			addIssue(model, severityWarning, ruleUnknownFileType, path, 0, "could not detect file type")
 			return nil
 		}
 
 		// Read all the records.
 		records, err := r.ReadAll()
 
-		if err != nil || len(records) == 0 {
-			logrus.Warnf("parse (%s): error reading file", path)
+		if err != nil {
+			addIssue(model, severityError, ruleUnreadableFile, path, 0, "error reading file: %s", err)
+			return nil
+		}
+
+		if len(records) == 0 {
+			addIssue(model, severityWarning, ruleEmptyFile, path, 0, "no records")
 			return nil
 		}
 
@@ -284,27 +507,40 @@ func parseFiles(model *dms.Model) {
 			logrus.Debugf("parse (%s): adding fields file", path)
 			var tableRecords []dms.Attrs
 
-			for _, record := range records {
-				table = record["table"]
+			for i, attrs := range records {
+				table = attrs["table"]
 
 				if tableRecords, ok = tableFields[table]; !ok {
 					tableRecords = make([]dms.Attrs, 0)
+					tableFieldsLocs[table] = &record{path: path, line: i + 2}
 				}
 
-				tableRecords = append(tableRecords, record)
+				tableRecords = append(tableRecords, attrs)
 				tableFields[table] = tableRecords
 			}
 
 		case SchemataFile:
-			for _, r := range records {
+			for i, r := range records {
+				// 1 header + 1-indexed
+				lineno := i + 2
+
+				for _, sr := range sizeRules {
+					if v := r[sr.attr]; v != "" {
+						if _, err := strconv.Atoi(v); err != nil {
+							addIssue(model, severityError, sr.rule, path, lineno, "invalid %s `%s` of %s.%s", sr.attr, v, r["table"], r["field"])
+						}
+					}
+				}
+
 				fieldSchemata.Add(r["table"], r["field"], r)
 			}
 
 		case ReferencesFile:
-			for _, r := range records {
-				refs = append(refs, &dms.Reference{
-					Name:  r["name"],
-					Attrs: r,
+			for i, r := range records {
+				refs = append(refs, &record{
+					attrs: r,
+					path:  path,
+					line:  i + 2,
 				})
 
 				schema.AddForeignKey(r)
@@ -328,6 +564,11 @@ func parseFiles(model *dms.Model) {
 			for _, r := range records {
 				schema.AddIndex(r)
 			}
+
+		case RenamesFile:
+			// Renames refer to the previous version of the model and are
+			// linked by parseRenames once all models are parsed.
+			logrus.Debugf("parse (%s): skipping renames file", path)
 		}
 
 		return nil
@@ -388,28 +629,17 @@ func parseFiles(model *dms.Model) {
 			if sattrs := fieldSchemata.Get(t.Name, f.Name); sattrs != nil {
 				f.Type = sattrs["type"]
 
-				if sattrs["length"] != "" {
-					if l, err := strconv.Atoi(sattrs["length"]); err != nil {
-						logrus.Error("invalid length %s", sattrs["length"])
-					} else {
-						f.Length = l
-					}
+				// Invalid sizes are reported when the schema is read.
+				if l, err := strconv.Atoi(sattrs["length"]); err == nil {
+					f.Length = l
 				}
 
-				if sattrs["precision"] != "" {
-					if l, err := strconv.Atoi(sattrs["precision"]); err != nil {
-						logrus.Error("invalid precision %s", sattrs["precision"])
-					} else {
-						f.Precision = l
-					}
+				if l, err := strconv.Atoi(sattrs["precision"]); err == nil {
+					f.Precision = l
 				}
 
-				if sattrs["scale"] != "" {
-					if l, err := strconv.Atoi(sattrs["scale"]); err != nil {
-						logrus.Error("invalid scale %s", sattrs["scale"])
-					} else {
-						f.Scale = l
-					}
+				if l, err := strconv.Atoi(sattrs["scale"]); err == nil {
+					f.Scale = l
 				}
 
 				f.Default = sattrs["default"]
@@ -419,42 +649,67 @@ func parseFiles(model *dms.Model) {
 		}
 	}
 
+	// Fields of tables that are not declared in a tables file are dropped.
+	for table, loc := range tableFieldsLocs {
+		if model.Tables.Get(table) == nil {
+			addIssue(model, severityWarning, ruleUndeclaredTable, loc.path, loc.line, "fields of undeclared table `%s`", table)
+		}
+	}
+
+	// Tables take precedence over the resources of the model version that
+	// share their path segment.
+	for _, t := range model.Tables.List() {
+		name := strings.ToLower(t.Name)
+
+		if _, ok := modelVersionResources[name]; ok {
+			addIssue(model, severityInfo, ruleShadowedResource, model.Path, 0, "table `%s` hides the /%s resource", t.Name, name)
+		} else if _, ok := modelVersionSubresources[name]; ok {
+			addIssue(model, severityInfo, ruleShadowedResource, model.Path, 0, "table `%s` hides the /%s resources of its fields", t.Name, name)
+		}
+	}
+
 	var (
 		rt *dms.Table
 		rf *dms.Field
 	)
 
 	// Add references.
-	for _, ref := range refs {
-		t = model.Tables.Get(ref.Attrs["table"])
+	for _, r := range refs {
+		attrs = r.attrs
+
+		t = model.Tables.Get(attrs["table"])
 
 		if t == nil {
-			logrus.Warnf("refs (%s): no source table `%s`", model.Path, ref.Attrs["table"])
+			addIssue(model, severityError, ruleDanglingRef, r.path, r.line, "no source table `%s`", attrs["table"])
 			continue
 		}
 
-		f = t.Fields.Get(ref.Attrs["field"])
+		f = t.Fields.Get(attrs["field"])
 
 		if f == nil {
-			logrus.Warnf("refs (%s:%s): no source field `%s`", model.Path, t.Name, ref.Attrs["field"])
+			addIssue(model, severityError, ruleDanglingRef, r.path, r.line, "no source field `%s` in %s", attrs["field"], t.Name)
 			continue
 		}
 
-		rt = model.Tables.Get(ref.Attrs["ref_table"])
+		rt = model.Tables.Get(attrs["ref_table"])
 
 		if rt == nil {
-			logrus.Warnf("refs (%s): could not reference table `%s` by %s", model.Path, ref.Attrs["ref_table"], f)
+			addIssue(model, severityError, ruleDanglingRef, r.path, r.line, "could not reference table `%s` by %s", attrs["ref_table"], f)
 			continue
 		}
 
-		rf = rt.Fields.Get(ref.Attrs["ref_field"])
+		rf = rt.Fields.Get(attrs["ref_field"])
 
 		if rf == nil {
-			logrus.Warnf("refs (%s): could not reference field `%s` by %s", model.Path, ref.Attrs["ref_field"], f)
+			addIssue(model, severityError, ruleDanglingRef, r.path, r.line, "could not reference field `%s` by %s", attrs["ref_field"], f)
 			continue
 		}
 
-		ref.Field = rf
+		ref := &dms.Reference{
+			Name:  attrs["name"],
+			Field: rf,
+			Attrs: attrs,
+		}
 
 		// Add references
 		f.References = ref
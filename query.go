@@ -0,0 +1,723 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Field queries are expressions over the attributes of fields, e.g.
+//
+//	type = varchar and length > 255
+//	required = true and not_null = false
+//	references = person.person_id
+//
+// Comparisons are combined with and, or, not and parentheses. Values
+// containing spaces or operators are quoted with single or double quotes.
+
+// Kinds of query attributes which determine how values are compared.
+const (
+	queryString = iota
+	queryNumber
+	queryBool
+)
+
+// queryAttr is an attribute of a field that can be queried.
+type queryAttr struct {
+	kind int
+	get  func(f *dms.Field, ts *tableSchema) string
+}
+
+func boolString(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+// sizeString returns the size as a string or an empty string if it is not
+// set, which matches no comparison.
+func sizeString(n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(n)
+}
+
+var queryAttrs = map[string]*queryAttr{
+	"model":       {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Table.Model.Name }},
+	"version":     {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Table.Model.QualifiedVersion() }},
+	"table":       {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Table.Name }},
+	"field":       {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Name }},
+	"label":       {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Label }},
+	"description": {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Description }},
+	"type":        {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Type }},
+	"default":     {queryString, func(f *dms.Field, _ *tableSchema) string { return f.Default }},
+	"length":      {queryNumber, func(f *dms.Field, _ *tableSchema) string { return sizeString(f.Length) }},
+	"precision":   {queryNumber, func(f *dms.Field, _ *tableSchema) string { return sizeString(f.Precision) }},
+	"scale":       {queryNumber, func(f *dms.Field, _ *tableSchema) string { return sizeString(f.Scale) }},
+	"required":    {queryBool, func(f *dms.Field, _ *tableSchema) string { return boolString(f.Required) }},
+
+	// Declared by a not null constraint.
+	"not_null": {queryBool, func(f *dms.Field, ts *tableSchema) string { return boolString(ts.IsNotNull(f.Name)) }},
+
+	"primary_key": {queryBool, func(f *dms.Field, ts *tableSchema) string {
+		if ts.PrimaryKey == nil {
+			return boolString(false)
+		}
+
+		for _, n := range ts.PrimaryKey.Fields {
+			if strings.EqualFold(n, f.Name) {
+				return boolString(true)
+			}
+		}
+
+		return boolString(false)
+	}},
+
+	// The referenced field as table.field.
+	"references": {queryString, func(f *dms.Field, _ *tableSchema) string {
+		if f.References == nil || f.References.Field == nil {
+			return ""
+		}
+
+		return f.References.Field.Table.Name + "." + f.References.Field.Name
+	}},
+
+	// Number of fields referencing the field.
+	"referenced_by": {queryNumber, func(f *dms.Field, _ *tableSchema) string { return strconv.Itoa(len(f.InboundRefs)) }},
+
+	// Number of fields the field is mapped to.
+	"mappings": {queryNumber, func(f *dms.Field, _ *tableSchema) string { return strconv.Itoa(len(f.Mappings)) }},
+}
+
+func queryAttrNames() []string {
+	names := make([]string, 0, len(queryAttrs))
+
+	for k := range queryAttrs {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// FieldQuery is a parsed field query or a part of one.
+type FieldQuery interface {
+	Match(f *dms.Field, ts *tableSchema) bool
+}
+
+type andNode struct{ left, right FieldQuery }
+
+func (n *andNode) Match(f *dms.Field, ts *tableSchema) bool {
+	return n.left.Match(f, ts) && n.right.Match(f, ts)
+}
+
+type orNode struct{ left, right FieldQuery }
+
+func (n *orNode) Match(f *dms.Field, ts *tableSchema) bool {
+	return n.left.Match(f, ts) || n.right.Match(f, ts)
+}
+
+type notNode struct{ node FieldQuery }
+
+func (n *notNode) Match(f *dms.Field, ts *tableSchema) bool {
+	return !n.node.Match(f, ts)
+}
+
+type compareNode struct {
+	attr  *queryAttr
+	op    string
+	value string
+	num   int
+}
+
+func (n *compareNode) Match(f *dms.Field, ts *tableSchema) bool {
+	v := n.attr.get(f, ts)
+
+	if n.attr.kind == queryNumber {
+		x, err := strconv.Atoi(v)
+
+		// Absent values are neither equal nor unequal to a number.
+		if err != nil {
+			return false
+		}
+
+		switch n.op {
+		case "=":
+			return x == n.num
+		case "!=":
+			return x != n.num
+		case ">":
+			return x > n.num
+		case ">=":
+			return x >= n.num
+		case "<":
+			return x < n.num
+		case "<=":
+			return x <= n.num
+		}
+
+		return false
+	}
+
+	v = strings.ToLower(v)
+
+	switch n.op {
+	case "=":
+		return v == n.value
+	case "!=":
+		return v != n.value
+	case "~":
+		return strings.Contains(v, n.value)
+	}
+
+	return false
+}
+
+// Tokens of the query language.
+const (
+	tokEOF = iota
+	tokWord
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+type queryToken struct {
+	kind int
+	text string
+	pos  int
+}
+
+// QueryError is an error in a query at a position.
+type QueryError struct {
+	Pos int
+	Msg string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("query error at %d: %s", e.Pos+1, e.Msg)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
+
+func lexQuery(s string) ([]*queryToken, error) {
+	var toks []*queryToken
+
+	rs := []rune(s)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			toks = append(toks, &queryToken{tokLParen, "(", i})
+			i++
+
+		case r == ')':
+			toks = append(toks, &queryToken{tokRParen, ")", i})
+			i++
+
+		case r == '"' || r == '\'':
+			j := i + 1
+
+			for j < len(rs) && rs[j] != r {
+				j++
+			}
+
+			if j == len(rs) {
+				return nil, &QueryError{i, "unterminated string"}
+			}
+
+			toks = append(toks, &queryToken{tokString, string(rs[i+1 : j]), i})
+			i = j + 1
+
+		case r == '=' || r == '~':
+			toks = append(toks, &queryToken{tokOp, string(r), i})
+			i++
+
+		case r == '!' || r == '>' || r == '<':
+			if i+1 < len(rs) && rs[i+1] == '=' {
+				toks = append(toks, &queryToken{tokOp, string(rs[i : i+2]), i})
+				i += 2
+			} else if r == '!' {
+				return nil, &QueryError{i, "expected !="}
+			} else {
+				toks = append(toks, &queryToken{tokOp, string(r), i})
+				i++
+			}
+
+		case isWordRune(r):
+			j := i
+
+			for j < len(rs) && isWordRune(rs[j]) {
+				j++
+			}
+
+			toks = append(toks, &queryToken{tokWord, string(rs[i:j]), i})
+			i = j
+
+		default:
+			return nil, &QueryError{i, fmt.Sprintf("unexpected %q", r)}
+		}
+	}
+
+	toks = append(toks, &queryToken{tokEOF, "", len(rs)})
+
+	return toks, nil
+}
+
+type queryParser struct {
+	toks []*queryToken
+	i    int
+}
+
+func (p *queryParser) peek() *queryToken {
+	return p.toks[p.i]
+}
+
+func (p *queryParser) next() *queryToken {
+	t := p.toks[p.i]
+
+	if t.kind != tokEOF {
+		p.i++
+	}
+
+	return t
+}
+
+func (p *queryParser) keyword(k string) bool {
+	t := p.peek()
+
+	if t.kind == tokWord && strings.EqualFold(t.text, k) {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+func (p *queryParser) parseOr() (FieldQuery, error) {
+	left, err := p.parseAnd()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (FieldQuery, error) {
+	left, err := p.parseUnary()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword("and") {
+		right, err := p.parseUnary()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseUnary() (FieldQuery, error) {
+	if p.keyword("not") {
+		n, err := p.parseUnary()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{n}, nil
+	}
+
+	if p.peek().kind == tokLParen {
+		p.next()
+
+		n, err := p.parseOr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if t := p.next(); t.kind != tokRParen {
+			return nil, &QueryError{t.pos, "expected )"}
+		}
+
+		return n, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *queryParser) parseComparison() (FieldQuery, error) {
+	t := p.next()
+
+	if t.kind != tokWord {
+		return nil, &QueryError{t.pos, "expected an attribute"}
+	}
+
+	op := p.next()
+
+	if op.kind != tokOp {
+		return nil, &QueryError{op.pos, "expected an operator"}
+	}
+
+	v := p.next()
+
+	if v.kind != tokWord && v.kind != tokString {
+		return nil, &QueryError{v.pos, "expected a value"}
+	}
+
+	n, err := newCompareNode(t.text, op.text, v.text)
+
+	if err != nil {
+		pos := []int{t.pos, op.pos, v.pos}[err.term]
+		return nil, &QueryError{pos, err.msg}
+	}
+
+	return n, nil
+}
+
+// Terms of a comparison an error refers to.
+const (
+	termAttr = iota
+	termOp
+	termValue
+)
+
+type compareError struct {
+	term int
+	msg  string
+}
+
+// newCompareNode checks the operator and value of a comparison against the
+// kind of the attribute.
+func newCompareNode(name, op, value string) (*compareNode, *compareError) {
+	attr, ok := queryAttrs[strings.ToLower(name)]
+
+	if !ok {
+		return nil, &compareError{termAttr, fmt.Sprintf("unknown attribute %q; supported attributes: %s", name, strings.Join(queryAttrNames(), ", "))}
+	}
+
+	switch op {
+	case "=", "!=", ">", ">=", "<", "<=", "~":
+	default:
+		return nil, &compareError{termOp, fmt.Sprintf("unknown operator %q", op)}
+	}
+
+	n := &compareNode{
+		attr:  attr,
+		op:    op,
+		value: strings.ToLower(value),
+	}
+
+	switch attr.kind {
+	case queryNumber:
+		num, err := strconv.Atoi(value)
+
+		if err != nil {
+			return nil, &compareError{termValue, fmt.Sprintf("%s is a number", name)}
+		}
+
+		if op == "~" {
+			return nil, &compareError{termOp, "~ applies to text"}
+		}
+
+		n.num = num
+
+	case queryBool:
+		switch n.value {
+		case "true", "yes", "y", "1":
+			n.value = "true"
+		case "false", "no", "n", "0":
+			n.value = "false"
+		default:
+			return nil, &compareError{termValue, fmt.Sprintf("%s is true or false", name)}
+		}
+
+		if op != "=" && op != "!=" {
+			return nil, &compareError{termOp, fmt.Sprintf("%s is true or false and is compared with = or !=", name)}
+		}
+
+	default:
+		if op != "=" && op != "!=" && op != "~" {
+			return nil, &compareError{termOp, fmt.Sprintf("%s is text and is compared with =, != or ~", name)}
+		}
+	}
+
+	return n, nil
+}
+
+// ParseFieldQuery parses a field query.
+func ParseFieldQuery(s string) (FieldQuery, error) {
+	toks, err := lexQuery(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{toks: toks}
+
+	if p.peek().kind == tokEOF {
+		return nil, &QueryError{0, "empty query"}
+	}
+
+	n, err := p.parseOr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &QueryError{t.pos, fmt.Sprintf("unexpected %q", t.text)}
+	}
+
+	return n, nil
+}
+
+// FieldFilter is a field query as JSON, e.g.
+//
+//	{"and": [
+//		{"attr": "type", "op": "=", "value": "varchar"},
+//		{"attr": "length", "op": ">", "value": 255}
+//	]}
+//
+// A filter is either a combination of filters with and, or or not, or a
+// comparison of an attribute.
+type FieldFilter struct {
+	And []*FieldFilter `json:"and,omitempty"`
+	Or  []*FieldFilter `json:"or,omitempty"`
+	Not *FieldFilter   `json:"not,omitempty"`
+
+	Attr  string      `json:"attr,omitempty"`
+	Op    string      `json:"op,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// filterValue returns the value of a comparison as in a query.
+func filterValue(v interface{}) (string, bool) {
+	switch x := v.(type) {
+	case string:
+		return x, true
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), true
+	case bool:
+		return boolString(x), true
+	}
+
+	return "", false
+}
+
+// Query returns the field query of the filter.
+func (ff *FieldFilter) Query() (FieldQuery, error) {
+	set := 0
+
+	for _, ok := range []bool{ff.And != nil, ff.Or != nil, ff.Not != nil, ff.Attr != ""} {
+		if ok {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return nil, errors.New("filter: expected one of and, or, not or attr")
+	}
+
+	switch {
+	case ff.Not != nil:
+		n, err := ff.Not.Query()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{n}, nil
+
+	case ff.And != nil, ff.Or != nil:
+		l, and := ff.Or, false
+
+		if ff.And != nil {
+			l, and = ff.And, true
+		}
+
+		if len(l) == 0 {
+			return nil, errors.New("filter: and and or require at least one filter")
+		}
+
+		var q FieldQuery
+
+		for _, f := range l {
+			n, err := f.Query()
+
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case q == nil:
+				q = n
+			case and:
+				q = &andNode{q, n}
+			default:
+				q = &orNode{q, n}
+			}
+		}
+
+		return q, nil
+	}
+
+	v, ok := filterValue(ff.Value)
+
+	if !ok {
+		return nil, fmt.Errorf("filter: %s: expected a string, number or boolean value", ff.Attr)
+	}
+
+	op := ff.Op
+
+	if op == "" {
+		op = "="
+	}
+
+	n, err := newCompareNode(ff.Attr, op, v)
+
+	if err != nil {
+		return nil, fmt.Errorf("filter: %s", err.msg)
+	}
+
+	return n, nil
+}
+
+// ParseFieldFilter parses a field query as a JSON filter.
+func ParseFieldFilter(r io.Reader) (FieldQuery, error) {
+	var ff FieldFilter
+
+	if err := json.NewDecoder(r).Decode(&ff); err != nil {
+		return nil, fmt.Errorf("filter: %s", err)
+	}
+
+	return ff.Query()
+}
+
+// QueryFields returns the fields of the models matching the query.
+func QueryFields(models *dms.Models, q FieldQuery) []*dms.Field {
+	fields := make([]*dms.Field, 0)
+
+	for _, m := range models.List() {
+		schema := indexSchema(m.Schema)
+
+		for _, t := range m.Tables.List() {
+			ts := schemaForTable(schema, t.Name)
+
+			for _, f := range t.Fields.List() {
+				if q.Match(f, ts) {
+					fields = append(fields, f)
+				}
+			}
+		}
+	}
+
+	return fields
+}
+
+// fieldQueryResult is the representation of a field matching a query.
+type fieldQueryResult struct {
+	Model      string `json:"model"`
+	Version    string `json:"version"`
+	Table      string `json:"table"`
+	Field      string `json:"field"`
+	Type       string `json:"type"`
+	Length     int    `json:"length"`
+	Precision  int    `json:"precision"`
+	Scale      int    `json:"scale"`
+	Required   bool   `json:"required"`
+	References string `json:"references,omitempty"`
+	Path       string `json:"path"`
+}
+
+func fieldQueryResults(fields []*dms.Field) []*fieldQueryResult {
+	results := make([]*fieldQueryResult, len(fields))
+
+	for i, f := range fields {
+		m := f.Table.Model
+
+		results[i] = &fieldQueryResult{
+			Model:      m.Name,
+			Version:    m.QualifiedVersion(),
+			Table:      f.Table.Name,
+			Field:      f.Name,
+			Type:       f.Type,
+			Length:     f.Length,
+			Precision:  f.Precision,
+			Scale:      f.Scale,
+			Required:   f.Required,
+			References: queryAttrs["references"].get(f, nil),
+			Path:       fmt.Sprintf("/models/%s/%s/%s", m.URLPath(), f.Table.Name, f.Name),
+		}
+	}
+
+	return results
+}
+
+// WriteFieldQueryMarkdown writes the fields matching a query as a
+// Markdown table.
+func WriteFieldQueryMarkdown(w io.Writer, q string, fields []*dms.Field) {
+	fmt.Fprintf(w, "# Query: `%s`\n\n", q)
+
+	fmt.Fprintf(w, "%d fields\n", len(fields))
+
+	if len(fields) == 0 {
+		return
+	}
+
+	fmt.Fprint(w, "\nModel | Table | Field | Type | Required | References\n")
+	fmt.Fprint(w, "----- | ----- | ----- | ---- | -------- | ----------\n")
+
+	for _, r := range fieldQueryResults(fields) {
+		typ := r.Type
+
+		switch {
+		case r.Precision > 0:
+			typ = fmt.Sprintf("%s(%d, %d)", typ, r.Precision, r.Scale)
+		case r.Length > 0:
+			typ = fmt.Sprintf("%s(%d)", typ, r.Length)
+		}
+
+		req := ""
+
+		if r.Required {
+			req = "yes"
+		}
+
+		fmt.Fprintf(w, "%s/%s | %s | [%s](%s) | %s | %s | %s\n", r.Model, r.Version, r.Table, r.Field, r.Path, typ, req, r.References)
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+func TestParseFieldQueryErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{"", "query error at 1: empty query"},
+		{"typ = varchar", `query error at 1: unknown attribute "typ"`},
+		{"type varchar", "query error at 6: expected an operator"},
+		{"type =", "query error at 7: expected a value"},
+		{"type ! varchar", "query error at 6: expected !="},
+		{"type = 'varchar", "query error at 8: unterminated string"},
+		{"length > big", "query error at 10: length is a number"},
+		{"length ~ 5", "query error at 8: ~ applies to text"},
+		{"required = maybe", "query error at 12: required is true or false"},
+		{"required > true", "query error at 10: required is true or false and is compared with = or !="},
+		{"type < varchar", "query error at 6: type is text and is compared with =, != or ~"},
+		{"(type = varchar", "query error at 16: expected )"},
+		{"type = varchar length > 5", `query error at 16: unexpected "length"`},
+	}
+
+	for _, test := range tests {
+		_, err := ParseFieldQuery(test.query)
+
+		if err == nil {
+			t.Errorf("%q: expected error", test.query)
+			continue
+		}
+
+		if !strings.HasPrefix(err.Error(), test.err) {
+			t.Errorf("%q: expected error %q, got %q", test.query, test.err, err)
+		}
+	}
+}
+
+func queryTestModels(t *testing.T) *dms.Models {
+	fields := "person_id,Person id,yes\n" +
+		"name,Name of the person,yes\n" +
+		"notes,Notes,no\n" +
+		"weight,Weight,no\n"
+
+	schema := "person_id,integer,,,,\n" +
+		"name,varchar,512,,,\n" +
+		"notes,varchar,100,,,\n" +
+		"weight,decimal,10,8,2,\n"
+
+	models := new(dms.Models)
+	models.Add(loadTestModel(t, "1.0.0", fields, schema))
+
+	return models
+}
+
+// queryFieldNames returns the names of the fields matching the query.
+func queryFieldNames(models *dms.Models, q FieldQuery) string {
+	var names []string
+
+	for _, f := range QueryFields(models, q) {
+		names = append(names, f.Name)
+	}
+
+	return strings.Join(names, ",")
+}
+
+func TestQueryFields(t *testing.T) {
+	models := queryTestModels(t)
+
+	tests := []struct {
+		query  string
+		fields string
+	}{
+		{"type = varchar", "name,notes"},
+		{"TYPE = VARCHAR", "name,notes"},
+		{"type = varchar and length > 255", "name"},
+		{"length >= 100 and length <= 100", "notes"},
+		{"required = yes", "name,person_id"},
+		{"required = true and not_null = false", "name,person_id"},
+		{"description ~ person", "name,person_id"},
+		{"description ~ 'of the'", "name"},
+		{"not type = varchar", "person_id,weight"},
+		{"type = integer or scale != 0", "person_id,weight"},
+
+		// Unset sizes match no comparison.
+		{"length < 200", "notes,weight"},
+		{"scale != 2", ""},
+		{"not length > 0", "person_id"},
+		{"not (type = varchar or type = decimal)", "person_id"},
+		{"version = 1.0.0 and field = weight", "weight"},
+		{"references = person.person_id", ""},
+	}
+
+	for _, test := range tests {
+		q, err := ParseFieldQuery(test.query)
+
+		if err != nil {
+			t.Errorf("%q: %s", test.query, err)
+			continue
+		}
+
+		if names := queryFieldNames(models, q); names != test.fields {
+			t.Errorf("%q: expected fields %q, got %q", test.query, test.fields, names)
+		}
+	}
+}
+
+func TestParseFieldFilter(t *testing.T) {
+	models := queryTestModels(t)
+
+	tests := []struct {
+		filter string
+		fields string
+		err    string
+	}{
+		{`{"attr": "type", "value": "varchar"}`, "name,notes", ""},
+		{`{"and": [{"attr": "type", "value": "varchar"}, {"attr": "length", "op": ">", "value": 255}]}`, "name", ""},
+		{`{"or": [{"attr": "type", "value": "integer"}, {"attr": "scale", "op": "!=", "value": 0}]}`, "person_id,weight", ""},
+		{`{"not": {"attr": "required", "value": true}}`, "notes,weight", ""},
+		{`{}`, "", "filter: expected one of and, or, not or attr"},
+		{`{"attr": "type", "value": "varchar", "not": {"attr": "length", "value": 5}}`, "", "filter: expected one of and, or, not or attr"},
+		{`{"and": []}`, "", "filter: and and or require at least one filter"},
+		{`{"attr": "type"}`, "", "filter: type: expected a string, number or boolean value"},
+		{`{"attr": "required", "op": ">", "value": true}`, "", "filter: required is true or false and is compared with = or !="},
+		{`{"attr": "length", "op": "~", "value": 5}`, "", "filter: ~ applies to text"},
+		{`{"attr": "type", "op": "like", "value": "varchar"}`, "", `filter: unknown operator "like"`},
+		{`[`, "", "filter: unexpected EOF"},
+	}
+
+	for _, test := range tests {
+		q, err := ParseFieldFilter(strings.NewReader(test.filter))
+
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("%s: expected error %q, got %v", test.filter, test.err, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %s", test.filter, err)
+			continue
+		}
+
+		if names := queryFieldNames(models, q); names != test.fields {
+			t.Errorf("%s: expected fields %q, got %q", test.filter, test.fields, names)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// RenameMode controls whether fields that look like they were renamed are
+// detected when comparing models. Renames declared in a renames file are
+// always applied.
+type RenameMode int
+
+const (
+	RenamesOff RenameMode = iota
+	RenamesSuggest
+	RenamesApply
+)
+
+const defaultRenameMode = RenamesSuggest
+
+var renameModes = map[string]RenameMode{
+	"off":     RenamesOff,
+	"suggest": RenamesSuggest,
+	"apply":   RenamesApply,
+}
+
+// Minimum confidence of a suggested rename.
+const renameThreshold = 0.6
+
+// Minimum similarity of the descriptions of a suggested rename.
+const renameDescriptionThreshold = 0.9
+
+// Minimum similarity of the names of a suggested rename.
+const renameNameThreshold = 0.4
+
+// RenameSuggestion is a removed field that is likely to have been renamed to
+// an added field of the same table.
+type RenameSuggestion struct {
+	From       string  `json:"from"`
+	To         string  `json:"to"`
+	Confidence float64 `json:"confidence"`
+}
+
+type suggestionsByConfidence []*RenameSuggestion
+
+func (s suggestionsByConfidence) Len() int { return len(s) }
+func (s suggestionsByConfidence) Less(i, j int) bool {
+	if s[i].Confidence == s[j].Confidence {
+		if s[i].From == s[j].From {
+			return s[i].To < s[j].To
+		}
+
+		return s[i].From < s[j].From
+	}
+
+	return s[i].Confidence > s[j].Confidence
+}
+func (s suggestionsByConfidence) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+
+	if c < a {
+		a = c
+	}
+
+	return a
+}
+
+// similarity returns a ratio between 0 and 1 of how similar two strings are
+// based on the edit distance.
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	max := len(a)
+
+	if len(b) > max {
+		max = len(b)
+	}
+
+	return 1 - float64(levenshtein(a, b))/float64(max)
+}
+
+// maxSimilarity returns the upper bound of the similarity of two strings
+// given their lengths.
+func maxSimilarity(a, b string) float64 {
+	short, long := len(a), len(b)
+
+	if short > long {
+		short, long = long, short
+	}
+
+	return float64(short) / float64(long)
+}
+
+// sameSchema returns true if the schema attributes of the fields are identical.
+func sameSchema(a, b *dms.Field) bool {
+	return strings.ToLower(a.Type) == strings.ToLower(b.Type) &&
+		a.Length == b.Length &&
+		a.Precision == b.Precision &&
+		a.Scale == b.Scale &&
+		a.Default == b.Default &&
+		a.Required == b.Required
+}
+
+// renameConfidence scores how likely field a was renamed to field b. Zero is
+// returned if the schema differs, the names are not similar enough or the
+// descriptions are not near-identical. Missing descriptions are not evidence
+// of a rename so fields without descriptions are never suggested.
+func renameConfidence(a, b *dms.Field) float64 {
+	if !sameSchema(a, b) {
+		return 0
+	}
+
+	name := similarity(normalize(a.Name), normalize(b.Name))
+
+	if name < renameNameThreshold {
+		return 0
+	}
+
+	ad := normalize(a.Description)
+	bd := normalize(b.Description)
+
+	if ad == "" || bd == "" {
+		return 0
+	}
+
+	// The edit distance is at least the difference in length, skip
+	// computing it for descriptions that cannot be similar enough.
+	if maxSimilarity(ad, bd) < renameDescriptionThreshold {
+		return 0
+	}
+
+	desc := similarity(ad, bd)
+
+	if desc < renameDescriptionThreshold {
+		return 0
+	}
+
+	return 0.4*name + 0.6*desc
+}
+
+// suggestRenames pairs the removed and added fields of a table diff that are
+// likely renames. Each field is part of at most one suggestion, the pairs
+// with the highest confidence are chosen first.
+func suggestRenames(at, bt *dms.Table, diff *Diff) []*RenameSuggestion {
+	var candidates []*RenameSuggestion
+
+	for _, rn := range diff.Removed {
+		af := at.Fields.Get(rn)
+
+		for _, an := range diff.Added {
+			bf := bt.Fields.Get(an)
+
+			if c := renameConfidence(af, bf); c >= renameThreshold {
+				candidates = append(candidates, &RenameSuggestion{
+					From:       rn,
+					To:         an,
+					Confidence: float64(int(c*100+0.5)) / 100,
+				})
+			}
+		}
+	}
+
+	sort.Sort(suggestionsByConfidence(candidates))
+
+	var suggestions []*RenameSuggestion
+
+	used := make(map[string]bool)
+
+	for _, s := range candidates {
+		if used["-"+s.From] || used["+"+s.To] {
+			continue
+		}
+
+		used["-"+s.From] = true
+		used["+"+s.To] = true
+
+		suggestions = append(suggestions, s)
+	}
+
+	return suggestions
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+func TestRenameConfidence(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *dms.Field
+		ok   bool
+	}{
+		{
+			"similar names and descriptions",
+			&dms.Field{Name: "dose", Type: "decimal", Description: "Dose value"},
+			&dms.Field{Name: "dose_value", Type: "decimal", Description: "Dose value."},
+			true,
+		},
+		{
+			"identical descriptions, unrelated names",
+			&dms.Field{Name: "dose", Type: "decimal", Description: "Dose value"},
+			&dms.Field{Name: "quantity", Type: "decimal", Description: "Dose value"},
+			false,
+		},
+		{
+			"no descriptions",
+			&dms.Field{Name: "npi", Type: "string", Length: 20},
+			&dms.Field{Name: "npi_code", Type: "string", Length: 20},
+			false,
+		},
+		{
+			"one description",
+			&dms.Field{Name: "npi", Type: "string", Description: "NPI"},
+			&dms.Field{Name: "npi_code", Type: "string"},
+			false,
+		},
+		{
+			"different descriptions",
+			&dms.Field{Name: "dose", Type: "decimal", Description: "Dose value"},
+			&dms.Field{Name: "dose_unit", Type: "decimal", Description: "Unit of the dose"},
+			false,
+		},
+		{
+			"different types",
+			&dms.Field{Name: "dose", Type: "decimal", Description: "Dose value"},
+			&dms.Field{Name: "dose_value", Type: "string", Description: "Dose value"},
+			false,
+		},
+		{
+			"different required",
+			&dms.Field{Name: "dose", Type: "decimal", Description: "Dose value"},
+			&dms.Field{Name: "dose_value", Type: "decimal", Description: "Dose value", Required: true},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		c := renameConfidence(test.a, test.b)
+
+		if ok := c >= renameThreshold; ok != test.ok {
+			t.Errorf("%s: expected suggestion %t, got confidence %.2f", test.name, test.ok, c)
+		}
+	}
+}
+
+func TestSuggestRenames(t *testing.T) {
+	at := &dms.Table{Name: "drug_exposure", Fields: &dms.Fields{}}
+	bt := &dms.Table{Name: "drug_exposure", Fields: &dms.Fields{}}
+
+	at.Fields.Add(&dms.Field{Name: "dose", Type: "decimal", Description: "Dose value"})
+	at.Fields.Add(&dms.Field{Name: "route", Type: "string", Description: "Route of administration"})
+
+	bt.Fields.Add(&dms.Field{Name: "dose_value", Type: "decimal", Description: "Dose value."})
+	bt.Fields.Add(&dms.Field{Name: "dose_val", Type: "decimal", Description: "Dose value"})
+	bt.Fields.Add(&dms.Field{Name: "quantity", Type: "string", Description: "Route of administration"})
+
+	diff := &Diff{
+		Removed: []string{"dose", "route"},
+		Added:   []string{"dose_val", "dose_value", "quantity"},
+	}
+
+	s := suggestRenames(at, bt, diff)
+
+	if len(s) != 1 {
+		t.Fatalf("expected 1 suggestion, got %d", len(s))
+	}
+
+	// The identical description outweighs the closer name.
+	if s[0].From != "dose" || s[0].To != "dose_val" {
+		t.Errorf("expected dose to dose_val, got %s to %s", s[0].From, s[0].To)
+	}
+}
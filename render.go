@@ -61,12 +61,72 @@ func RenderModelMarkdown(w io.Writer, v interface{}) {
 	renderMarkdown(w, "assets/models.md", v)
 }
 
-func RenderModelVersionMarkdown(w io.Writer, v interface{}) {
-	renderMarkdown(w, "assets/full.md", v)
+func RenderModelVersionMarkdown(w io.Writer, m *client.Model) {
+	renderMarkdown(w, "assets/full.md", newModelPage(m))
 }
 
-func RenderModelCompareMarkdown(w io.Writer, m1 *client.Model, m2 *client.Model) {
-	DiffModels(w, m1, m2)
+func RenderTableMarkdown(w io.Writer, t *client.Table) {
+	renderMarkdown(w, "assets/table.md", newTablePage(t))
+}
+
+func RenderFieldMarkdown(w io.Writer, f *client.Field) {
+	renderMarkdown(w, "assets/field.md", newFieldPage(f))
+}
+
+func RenderERDMarkdown(w io.Writer, e *ERD) {
+	WriteERDMarkdown(w, e)
+}
+
+func RenderJoinPathsMarkdown(w io.Writer, m *client.Model, from, to *client.Table, paths [][]*JoinStep) {
+	WriteJoinPathsMarkdown(w, m, from, to, paths)
+}
+
+func RenderNeighborsMarkdown(w io.Writer, m *client.Model, t *client.Table, steps []*JoinStep) {
+	WriteNeighborsMarkdown(w, m, t, steps)
+}
+
+func RenderLineageMarkdown(w io.Writer, l *Lineage) {
+	WriteLineageMarkdown(w, l)
+}
+
+func RenderMappingCoverageMarkdown(w io.Writer, c *MappingCoverage) {
+	c.WriteMarkdown(w)
+}
+
+func RenderMappingCoverageCSV(w io.Writer, c *MappingCoverage) {
+	c.WriteCSV(w)
+}
+
+func RenderMappingETLSQL(w io.Writer, source, target *client.Model, d Dialect) {
+	WriteETLSQL(w, source, target, d)
+}
+
+func RenderAttrHistoryMarkdown(w io.Writer, h *AttrHistory) {
+	h.WriteMarkdown(w)
+}
+
+func RenderModelVersionDDL(w io.Writer, m *client.Model, d Dialect) {
+	WriteModelDDL(w, m, d)
+}
+
+func RenderModelIssuesMarkdown(w io.Writer, m *client.Model) {
+	WriteIssuesMarkdown(w, m)
+}
+
+func RenderModelCompareMarkdown(w io.Writer, diff *ModelDiff) {
+	diff.WriteMarkdown(w)
+}
+
+func RenderModelCompareSQL(w io.Writer, diff *ModelDiff, d Dialect) {
+	WriteMigrationSQL(w, diff, d)
+}
+
+func RenderSearchMarkdown(w io.Writer, q *SearchQuery, results []*SearchResult, total int) {
+	WriteSearchMarkdown(w, q, results, total)
+}
+
+func RenderFieldQueryMarkdown(w io.Writer, q string, fields []*client.Field) {
+	WriteFieldQueryMarkdown(w, q, fields)
 }
 
 func RenderReposMarkdown(w io.Writer, v interface{}) {
@@ -93,15 +153,81 @@ func RenderModelHTML(w io.Writer, v interface{}) {
 	renderHTML(w, b.Bytes())
 }
 
-func RenderModelVersionHTML(w io.Writer, v interface{}) {
+func RenderModelVersionHTML(w io.Writer, m *client.Model) {
+	b := bytes.Buffer{}
+	RenderModelVersionMarkdown(&b, m)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderTableHTML(w io.Writer, t *client.Table) {
+	b := bytes.Buffer{}
+	RenderTableMarkdown(&b, t)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderFieldHTML(w io.Writer, f *client.Field) {
+	b := bytes.Buffer{}
+	RenderFieldMarkdown(&b, f)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderERDHTML(w io.Writer, e *ERD) {
+	b := bytes.Buffer{}
+	WriteERDMarkdown(&b, e)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderJoinPathsHTML(w io.Writer, m *client.Model, from, to *client.Table, paths [][]*JoinStep) {
+	b := bytes.Buffer{}
+	WriteJoinPathsMarkdown(&b, m, from, to, paths)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderNeighborsHTML(w io.Writer, m *client.Model, t *client.Table, steps []*JoinStep) {
+	b := bytes.Buffer{}
+	WriteNeighborsMarkdown(&b, m, t, steps)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderLineageHTML(w io.Writer, l *Lineage) {
+	b := bytes.Buffer{}
+	WriteLineageMarkdown(&b, l)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderMappingCoverageHTML(w io.Writer, c *MappingCoverage) {
+	b := bytes.Buffer{}
+	c.WriteMarkdown(&b)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderAttrHistoryHTML(w io.Writer, h *AttrHistory) {
+	b := bytes.Buffer{}
+	h.WriteMarkdown(&b)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderModelIssuesHTML(w io.Writer, m *client.Model) {
+	b := bytes.Buffer{}
+	WriteIssuesMarkdown(&b, m)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderModelCompareHTML(w io.Writer, diff *ModelDiff) {
+	b := bytes.Buffer{}
+	diff.WriteMarkdown(&b)
+	renderHTML(w, b.Bytes())
+}
+
+func RenderSearchHTML(w io.Writer, q *SearchQuery, results []*SearchResult, total int) {
 	b := bytes.Buffer{}
-	renderMarkdown(&b, "assets/full.md", v)
+	WriteSearchMarkdown(&b, q, results, total)
 	renderHTML(w, b.Bytes())
 }
 
-func RenderModelCompareHTML(w io.Writer, m1 *client.Model, m2 *client.Model) {
+func RenderFieldQueryHTML(w io.Writer, q string, fields []*client.Field) {
 	b := bytes.Buffer{}
-	DiffModels(&b, m1, m2)
+	WriteFieldQueryMarkdown(&b, q, fields)
 	renderHTML(w, b.Bytes())
 }
 
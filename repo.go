@@ -1,15 +1,12 @@
 package main
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,7 +14,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var ErrInvalidRepo = errors.New("repo: invalid repo URI")
+var (
+	ErrInvalidRepo = errors.New("repo: invalid repo URI")
+	ErrRepoRefs    = errors.New("repo: multiple refs require a git repo")
+)
 
 const defaultRepoName = "https://github.com/chop-dbhi/data-models@master"
 
@@ -35,20 +35,28 @@ func (r *Repos) String() string {
 }
 
 func (r *Repos) Set(s string) error {
-	p, err := ParseRepo(s)
+	p, err := ParseRepos(s)
 
 	if err != nil {
 		return err
 	}
 
-	*r = append(*r, p)
+	*r = append(*r, p...)
 
 	return nil
 }
 
+// Repo is a ref of a git repository checked out in its own directory.
 type Repo struct {
-	URL    string
-	Branch string
+	URL string
+
+	// Ref is the branch or tag checked out.
+	Ref string
+
+	// Default is true for the first ref registered for the repository. The
+	// models of the other refs are addressed by their version qualified with
+	// the ref.
+	Default bool
 
 	FetchTime  time.Time
 	CommitSHA1 string
@@ -62,16 +70,26 @@ type Repo struct {
 	updating bool
 	path     string
 	git      bool
+	client   GitClient
+
+	// The ref was given rather than defaulted.
+	explicitRef bool
+
+	// The working tree of a local repo is not at the ref, its models are
+	// not served.
+	offRef bool
 }
 
 func (r *Repo) String() string {
-	return fmt.Sprintf("%s@%s", r.URL, r.Branch)
+	return fmt.Sprintf("%s@%s", r.URL, r.Ref)
 }
 
 func (r *Repo) MarshalJSON() ([]byte, error) {
 	aux := map[string]interface{}{
 		"uri":       r.URL,
-		"branch":    r.Branch,
+		"ref":       r.Ref,
+		"branch":    r.Ref,
+		"default":   r.Default,
 		"fetchTime": r.FetchTime,
 		"commit": map[string]interface{}{
 			"sha1": r.CommitSHA1,
@@ -82,100 +100,90 @@ func (r *Repo) MarshalJSON() ([]byte, error) {
 	return json.Marshal(aux)
 }
 
-func (r *Repo) info() {
-	cmd := exec.Command("git", "log", "-1", "--format=%H|%ct")
-
-	buf := bytes.NewBuffer(nil)
-
-	cmd.Dir = r.path
-	cmd.Stdout = buf
-
-	if err := cmd.Run(); err != nil {
-		logrus.Errorf("repo: error getting commit info: %s", err)
-	}
-
-	v := strings.TrimSpace(buf.String())
-	parts := strings.Split(v, "|")
-
-	ts, err := strconv.Atoi(parts[1])
+// info reads the commit checked out.
+func (r *Repo) info() error {
+	c, err := r.client.Head(r.path)
 
 	if err != nil {
-		logrus.Errorf("repo: error parsing timestamp: %s", err)
+		return fmt.Errorf("repo: error getting commit info of %s: %s", r, err)
 	}
 
 	r.prevSHA1 = r.CommitSHA1
-	r.CommitSHA1 = parts[0]
-	r.CommitTime = time.Unix(int64(ts), 0)
+	r.CommitSHA1 = c.SHA1
+	r.CommitTime = c.Time
 	r.FetchTime = time.Now()
-}
 
-func (r *Repo) hasOrigin() bool {
-	cmd := exec.Command("git", "remote")
+	return nil
+}
 
-	buf := bytes.NewBuffer(nil)
+func (r *Repo) clone() error {
+	if err := r.client.Clone(r.URL, r.Ref, r.path, cloneDepth); err != nil {
+		// Remove the partial clone so it is not mistaken for a checkout.
+		os.RemoveAll(r.path)
+		return fmt.Errorf("repo: problem cloning %s: %s", r, err)
+	}
 
-	cmd.Stdout = buf
-	cmd.Dir = r.path
-	cmd.Run()
+	logrus.Debugf("repo: cloned repo %s", r)
 
-	return strings.Contains(buf.String(), "origin\n")
+	return r.info()
 }
 
-func (r *Repo) clone() {
-	cmd := exec.Command("git", "clone", "--branch", r.Branch, r.URL, r.path)
+func (r *Repo) pull() error {
+	ok, err := r.client.HasOrigin(r.path)
+
+	if err != nil {
+		return fmt.Errorf("repo: problem opening %s: %s", r, err)
+	}
 
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	if ok {
+		if err = r.client.Pull(r.path, r.Ref, cloneDepth); err != nil {
+			return fmt.Errorf("repo: problem updating %s: %s", r, err)
+		}
 
-	if err := cmd.Run(); err != nil {
-		logrus.Fatalf("problem cloning repo: %s", err)
+		logrus.Debugf("repo: updated repo %s", r)
 	}
 
-	logrus.Debugf("repo: cloned repo %s", r)
-	r.info()
+	return r.info()
 }
 
-func (r *Repo) pull() {
-	if r.hasOrigin() {
-		cmd := exec.Command("git", "fetch", "origin")
-
-		cmd.Dir = r.path
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+// local returns true if the repo is served from its working tree rather
+// than a checkout of the service.
+func (r *Repo) local() bool {
+	return r.path == r.URL
+}
 
-		if err := cmd.Run(); err != nil {
-			logrus.Fatalf("problem fetching repo: %s", err)
-			return
-		}
+// checkRef returns an error if the working tree of a local repo is not at
+// the ref it is served as.
+func (r *Repo) checkRef() error {
+	head, err := r.client.Resolve(r.path, "HEAD")
 
-		remote := fmt.Sprintf("origin/%s", r.Branch)
-		cmd = exec.Command("git", "merge", remote)
+	if err != nil {
+		return fmt.Errorf("repo: problem resolving HEAD of %s: %s", r.URL, err)
+	}
 
-		cmd.Dir = r.path
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+	sha, err := r.client.Resolve(r.path, r.Ref)
 
-		if err := cmd.Run(); err != nil {
-			logrus.Fatalf("problem merging repo: %s", err)
-			return
-		}
+	if err != nil {
+		return fmt.Errorf("repo: %s has no ref %s", r.URL, r.Ref)
+	}
 
-		logrus.Debugf("repo: updated repo %s", r)
+	if head != sha {
+		return fmt.Errorf("repo: %s is not checked out at %s, its models are not served", r.URL, r.Ref)
 	}
 
-	r.info()
+	return nil
 }
 
 // updateRepo clones or updates the repo and returns true
 // if an update occurred.
-func (r *Repo) update() bool {
+func (r *Repo) update() (bool, error) {
 	// Update already in progress
 	if r.updating {
-		return false
+		return false, nil
 	}
 
 	if !r.git {
-		return true
+		return true, nil
 	}
 
 	r.Lock()
@@ -189,18 +197,42 @@ func (r *Repo) update() bool {
 
 	gitDir := filepath.Join(r.path, ".git")
 
-	if _, err := os.Stat(gitDir); err != nil {
-		r.clone()
+	var err error
+
+	if _, err = os.Stat(gitDir); err != nil {
+		err = r.clone()
 	} else {
-		r.pull()
+		err = r.pull()
+	}
+
+	if err != nil {
+		return false, err
 	}
 
-	return r.CommitSHA1 != r.prevSHA1
+	changed := r.CommitSHA1 != r.prevSHA1
+
+	// Refs other than the first are cloned, the first of a local repo is
+	// its working tree which is only checked.
+	if r.local() && r.explicitRef {
+		err = r.checkRef()
+
+		if offRef := err != nil; offRef != r.offRef {
+			r.offRef = offRef
+			changed = true
+		}
+	}
+
+	return changed, err
 }
 
-func ParseRepo(uri string) (*Repo, error) {
+// ParseRepos parses a repo URI with a comma-separated list of refs, e.g.
+// https://github.com/chop-dbhi/data-models@master,develop,v1.0.0. A repo is
+// returned for each ref. The first ref is the default and is checked out at
+// the usual path, the others next to it with the ref appended.
+func ParseRepos(uri string) ([]*Repo, error) {
 	r := &Repo{
-		Branch: "master",
+		Ref:     "master",
+		Default: true,
 	}
 
 	ssh := strings.HasPrefix(uri, "git@")
@@ -253,10 +285,47 @@ func ParseRepo(uri string) (*Repo, error) {
 	}
 
 	if len(toks) > 1 {
-		r.Branch = toks[1]
+		r.Ref = toks[1]
+	}
+
+	refs := strings.Split(r.Ref, ",")
+
+	if len(refs) > 1 && !r.git {
+		return nil, ErrRepoRefs
+	}
+
+	repos := make([]*Repo, len(refs))
+
+	for i, ref := range refs {
+		ref = strings.TrimSpace(ref)
+
+		if ref == "" {
+			return nil, ErrInvalidRepo
+		}
+
+		p := r.path
+
+		if i > 0 {
+			// Local repos are cloned into the repos directory.
+			if p == r.URL {
+				p = filepath.Join(reposDir, "local", p)
+			}
+
+			p = fmt.Sprintf("%s@%s", p, strings.Replace(ref, "/", "_", -1))
+		}
+
+		repos[i] = &Repo{
+			URL:         r.URL,
+			Ref:         ref,
+			Default:     i == 0,
+			path:        p,
+			git:         r.git,
+			client:      defaultGitClient,
+			explicitRef: len(toks) > 1,
+		}
 	}
 
-	return r, nil
+	return repos, nil
 }
 
 // Update all the repos.
@@ -268,7 +337,13 @@ func updateRepos() {
 
 	for _, r := range registeredRepos {
 		go func(r *Repo) {
-			if r.update() {
+			ok, err := r.update()
+
+			if err != nil {
+				logrus.Error(err)
+			}
+
+			if ok {
 				changed = true
 			}
 
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	dms "github.com/chop-dbhi/data-models-service/client"
+)
+
+// Kinds of searchable entities.
+const (
+	searchModel = "model"
+	searchTable = "table"
+	searchField = "field"
+)
+
+// Weights of the indexed text by where it occurs.
+const (
+	nameWeight        = 3.0
+	labelWeight       = 2.0
+	descriptionWeight = 1.0
+)
+
+const defaultSearchLimit = 50
+
+// SearchResult is a model, table or field matching a search.
+type SearchResult struct {
+	Kind        string  `json:"kind"`
+	Model       string  `json:"model"`
+	Version     string  `json:"version"`
+	Table       string  `json:"table,omitempty"`
+	Field       string  `json:"field,omitempty"`
+	Label       string  `json:"label,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Path        string  `json:"path"`
+	Score       float64 `json:"score"`
+}
+
+// Name returns the qualified name of the entity within its model.
+func (r *SearchResult) Name() string {
+	switch r.Kind {
+	case searchTable:
+		return r.Table
+	case searchField:
+		return r.Table + "." + r.Field
+	}
+
+	return r.Model
+}
+
+type posting struct {
+	doc    int
+	weight float64
+}
+
+// SearchIndex is an inverted index of the names, labels and descriptions of
+// the models, tables and fields in the cache.
+type SearchIndex struct {
+	docs  []*SearchResult
+	terms map[string][]*posting
+}
+
+// Initialize an empty index.
+var searchIndex = &SearchIndex{}
+
+var searchTokenRe = regexp.MustCompile(`[a-z0-9]+`)
+
+// searchTerms splits text into lowercase terms. Identifiers are indexed as a
+// whole as well as by their parts, e.g. visit_occurrence_id.
+func searchTerms(s string) []string {
+	s = strings.ToLower(s)
+
+	terms := searchTokenRe.FindAllString(s, -1)
+
+	for _, w := range strings.Fields(s) {
+		if strings.Contains(w, "_") {
+			terms = append(terms, strings.Trim(w, ".,;:()[]{}\"'`"))
+		}
+	}
+
+	return terms
+}
+
+func (x *SearchIndex) add(doc *SearchResult, name, label, desc string) {
+	id := len(x.docs)
+	x.docs = append(x.docs, doc)
+
+	weights := make(map[string]float64)
+
+	for _, t := range searchTerms(name) {
+		weights[t] += nameWeight
+	}
+
+	for _, t := range searchTerms(label) {
+		weights[t] += labelWeight
+	}
+
+	for _, t := range searchTerms(desc) {
+		weights[t] += descriptionWeight
+	}
+
+	for t, w := range weights {
+		x.terms[t] = append(x.terms[t], &posting{
+			doc:    id,
+			weight: w,
+		})
+	}
+}
+
+// buildSearchIndex indexes all models, tables and fields.
+func buildSearchIndex(models *dms.Models) *SearchIndex {
+	x := &SearchIndex{
+		terms: make(map[string][]*posting),
+	}
+
+	for _, m := range models.List() {
+		x.add(&SearchResult{
+			Kind:        searchModel,
+			Model:       m.Name,
+			Version:     m.QualifiedVersion(),
+			Label:       m.Label,
+			Description: m.Description,
+			Path:        "/models/" + m.URLPath(),
+		}, m.Name, m.Label, m.Description)
+
+		for _, t := range m.Tables.List() {
+			x.add(&SearchResult{
+				Kind:        searchTable,
+				Model:       m.Name,
+				Version:     m.QualifiedVersion(),
+				Table:       t.Name,
+				Label:       t.Label,
+				Description: t.Description,
+				Path:        fmt.Sprintf("/models/%s/%s", m.URLPath(), t.Name),
+			}, t.Name, t.Label, t.Description)
+
+			for _, f := range t.Fields.List() {
+				x.add(&SearchResult{
+					Kind:        searchField,
+					Model:       m.Name,
+					Version:     m.QualifiedVersion(),
+					Table:       t.Name,
+					Field:       f.Name,
+					Label:       f.Label,
+					Description: f.Description,
+					Path:        fmt.Sprintf("/models/%s/%s/%s", m.URLPath(), t.Name, f.Name),
+				}, f.Name, f.Label, f.Description)
+			}
+		}
+	}
+
+	return x
+}
+
+// SearchQuery is a full-text query with optional filters.
+type SearchQuery struct {
+	Text    string
+	Model   string
+	Version string
+	Kind    string
+	Limit   int
+}
+
+func (q *SearchQuery) matches(r *SearchResult) bool {
+	if q.Model != "" && !strings.EqualFold(q.Model, r.Model) {
+		return false
+	}
+
+	if q.Version != "" && q.Version != r.Version {
+		return false
+	}
+
+	if q.Kind != "" && q.Kind != r.Kind {
+		return false
+	}
+
+	return true
+}
+
+type searchResultsByScore []*SearchResult
+
+func (s searchResultsByScore) Len() int { return len(s) }
+func (s searchResultsByScore) Less(i, j int) bool {
+	if s[i].Score != s[j].Score {
+		return s[i].Score > s[j].Score
+	}
+
+	if s[i].Model != s[j].Model {
+		return s[i].Model < s[j].Model
+	}
+
+	if s[i].Version != s[j].Version {
+		return s[i].Version > s[j].Version
+	}
+
+	return s[i].Name() < s[j].Name()
+}
+func (s searchResultsByScore) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// Search returns the entities matching all terms of the query ranked by the
+// weight of the terms, rarer terms counting more. The total number of
+// matches is returned along with the limited results.
+func (x *SearchIndex) Search(q *SearchQuery) ([]*SearchResult, int) {
+	terms := searchTerms(q.Text)
+
+	if len(terms) == 0 || len(x.docs) == 0 {
+		return []*SearchResult{}, 0
+	}
+
+	scores := make(map[int]float64)
+	hits := make(map[int]int)
+
+	seen := make(map[string]bool)
+
+	for _, t := range terms {
+		// Repeated terms would otherwise never match all.
+		if seen[t] {
+			continue
+		}
+
+		seen[t] = true
+
+		ps := x.terms[t]
+
+		idf := math.Log(1 + float64(len(x.docs))/float64(len(ps)+1))
+
+		for _, p := range ps {
+			scores[p.doc] += p.weight * idf
+			hits[p.doc]++
+		}
+	}
+
+	results := make([]*SearchResult, 0)
+
+	for id, n := range hits {
+		if n < len(seen) {
+			continue
+		}
+
+		doc := x.docs[id]
+
+		if !q.matches(doc) {
+			continue
+		}
+
+		r := *doc
+		r.Score = math.Floor(scores[id]*1000+0.5) / 1000
+
+		results = append(results, &r)
+	}
+
+	sort.Sort(searchResultsByScore(results))
+
+	total := len(results)
+
+	if q.Limit > 0 && len(results) > q.Limit {
+		results = results[:q.Limit]
+	}
+
+	return results, total
+}
+
+// WriteSearchMarkdown writes the results of a search as a Markdown list.
+func WriteSearchMarkdown(w io.Writer, q *SearchQuery, results []*SearchResult, total int) {
+	fmt.Fprintf(w, "# Search: %s\n\n", q.Text)
+
+	if len(results) < total {
+		fmt.Fprintf(w, "Showing %d of %d results\n", len(results), total)
+	} else {
+		fmt.Fprintf(w, "%d results\n", total)
+	}
+
+	if len(results) > 0 {
+		fmt.Fprintln(w)
+	}
+
+	for _, r := range results {
+		fmt.Fprintf(w, "- %s [`%s`](%s) in %s/%s", r.Kind, r.Name(), r.Path, r.Model, r.Version)
+
+		if r.Description != "" {
+			fmt.Fprintf(w, ": %s", r.Description)
+		}
+
+		fmt.Fprintln(w)
+	}
+}
@@ -16,6 +16,7 @@ const (
 	ConstraintsFile
 	MappingsFile
 	ModelsFile
+	RenamesFile
 )
 
 var fileTypeStrings = map[FileType]string{
@@ -27,6 +28,7 @@ var fileTypeStrings = map[FileType]string{
 	ConstraintsFile: "constraints",
 	MappingsFile:    "mappings",
 	ModelsFile:      "models",
+	RenamesFile:     "renames",
 }
 
 // Mapping of file types to their minimum required fields.
@@ -104,6 +106,16 @@ var FileTypeFields = map[FileType][]string{
 		"description",
 		"url",
 	},
+
+	RenamesFile: {
+		"model",
+		"version",
+		"table",
+		"field",
+		"prev_version",
+		"prev_table",
+		"prev_field",
+	},
 }
 
 // Explict order since the tables file is a subset of fields.
@@ -117,6 +129,7 @@ var fileTypesOrder = []FileType{
 	TablesFile,
 	MappingsFile,
 	ModelsFile,
+	RenamesFile,
 }
 
 func hasFields(header, fields []string) bool {